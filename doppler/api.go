@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -12,13 +13,25 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"golang.org/x/net/http/httpproxy"
 )
 
 type APIClient struct {
-	Host      string
-	APIKey    string
-	VerifyTLS bool
+	Host       string
+	APIKey     string
+	HTTPClient *http.Client
+	// Set when authenticating via OIDC, in which case it supplies (and refreshes) the APIKey
+	OIDCSession *OIDCSession
+	// Set when the token is validated while configuring the provider
+	TokenInfo *TokenInfo
+	// Refuses any request that could modify Doppler (i.e. anything but GET)
+	ReadOnly bool
+	// Used by resources that omit their `project` or `config`
+	DefaultProject string
+	DefaultConfig  string
 }
 
 type APIResponse struct {
@@ -51,6 +64,11 @@ type PageOptions struct {
 
 const MAX_RETRIES = 10
 
+// How long a single request attempt may take before it is retried
+const defaultRequestTimeout = 30 * time.Second
+
+type requestTimeoutKey struct{}
+
 func (e *APIError) Error() string {
 	message := fmt.Sprintf("Doppler Error: %s", e.Message)
 	if underlyingError := e.Err; underlyingError != nil {
@@ -59,6 +77,85 @@ func (e *APIError) Error() string {
 	return message
 }
 
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// Typed API errors classify an APIError by the HTTP status of its response. Match them with errors.As (e.g. isNotFoundError).
+
+type NotFoundError struct{ *APIError }
+type UnauthorizedError struct{ *APIError }
+type ForbiddenError struct{ *APIError }
+type ConflictError struct{ *APIError }
+type RateLimitedError struct{ *APIError }
+type ServerError struct{ *APIError }
+
+// ValidationError is returned when the API rejects the request's parameters
+type ValidationError struct {
+	*APIError
+	FieldErrors []FieldError
+}
+
+// FieldError is a validation message that the API attributes to a single parameter
+type FieldError struct {
+	// The parameter's path, e.g. "name" or "credentials.0.password"
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e *NotFoundError) Unwrap() error     { return e.APIError }
+func (e *UnauthorizedError) Unwrap() error { return e.APIError }
+func (e *ForbiddenError) Unwrap() error    { return e.APIError }
+func (e *ConflictError) Unwrap() error     { return e.APIError }
+func (e *RateLimitedError) Unwrap() error  { return e.APIError }
+func (e *ServerError) Unwrap() error       { return e.APIError }
+func (e *ValidationError) Unwrap() error   { return e.APIError }
+
+// typedAPIError wraps an APIError in the typed error matching its response status, if any.
+func typedAPIError(err error) error {
+	apiError, ok := err.(*APIError)
+	if !ok || apiError.Response == nil {
+		return err
+	}
+	statusCode := apiError.Response.HTTPResponse.StatusCode
+	switch {
+	case statusCode == 400 || statusCode == 422:
+		return &ValidationError{APIError: apiError, FieldErrors: parseFieldErrors(apiError.Response.Body)}
+	case statusCode == 401:
+		return &UnauthorizedError{apiError}
+	case statusCode == 403:
+		return &ForbiddenError{apiError}
+	case statusCode == 404:
+		return &NotFoundError{apiError}
+	case statusCode == 409:
+		return &ConflictError{apiError}
+	case statusCode == 429:
+		return &RateLimitedError{apiError}
+	case statusCode >= 500:
+		return &ServerError{apiError}
+	}
+	return err
+}
+
+// parseFieldErrors reads the field-level validation messages of an error response, which are listed under `data.errors`.
+func parseFieldErrors(body []byte) []FieldError {
+	var errResponse struct {
+		Data struct {
+			Errors []FieldError `json:"errors"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &errResponse); err != nil {
+		return nil
+	}
+	fieldErrors := make([]FieldError, 0, len(errResponse.Data.Errors))
+	for _, fieldError := range errResponse.Data.Errors {
+		if fieldError.Field != "" && fieldError.Message != "" {
+			fieldErrors = append(fieldErrors, fieldError)
+		}
+	}
+	return fieldErrors
+}
+
 func isSuccess(statusCode int) bool {
 	return (statusCode >= 200 && statusCode <= 299) || (statusCode >= 300 && statusCode <= 399)
 }
@@ -68,54 +165,152 @@ func getSecondsDuration(seconds int) *time.Duration {
 	return &duration
 }
 
+// HTTPClientConfig configures the transport of the HTTP client used for Doppler API calls.
+type HTTPClientConfig struct {
+	VerifyTLS bool
+	// PEM-encoded CA certificates trusted in addition to the system roots
+	CACertPEM string
+	// PEM-encoded client certificate and key presented for mutual TLS
+	ClientCertPEM string
+	ClientKeyPEM  string
+	// Overrides the HTTPS_PROXY/HTTP_PROXY environment variables. NO_PROXY is honoured either way.
+	ProxyURL string
+}
+
 // httpClientWithTLSConfig builds the HTTP client used for all Doppler API calls, with a
-// shared TLS policy (minimum TLS 1.2, optional verification skip) and timeout.
-func httpClientWithTLSConfig(verifyTLS bool) *http.Client {
+// shared TLS policy (minimum TLS 1.2, optional verification skip) and proxy settings.
+func httpClientWithTLSConfig(config HTTPClientConfig) (*http.Client, error) {
 	tlsConfig := &tls.Config{
 		MinVersion: tls.VersionTLS12,
 	}
-	if !verifyTLS {
+	if !config.VerifyTLS {
 		tlsConfig.InsecureSkipVerify = true
 	}
+	if config.CACertPEM != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM([]byte(config.CACertPEM)) {
+			return nil, fmt.Errorf("no valid PEM-encoded certificates found in the CA certificate")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+	if config.ClientCertPEM != "" || config.ClientKeyPEM != "" {
+		clientCert, err := tls.X509KeyPair([]byte(config.ClientCertPEM), []byte(config.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if config.ProxyURL != "" {
+		proxyConfig := httpproxy.FromEnvironment()
+		proxyConfig.HTTPProxy = config.ProxyURL
+		proxyConfig.HTTPSProxy = config.ProxyURL
+		proxyFunc := proxyConfig.ProxyFunc()
+		proxy = func(req *http.Request) (*url.URL, error) {
+			return proxyFunc(req.URL)
+		}
+	}
+
+	// Each request attempt is bounded by its context rather than a fixed client timeout, see requestContext
 	return &http.Client{
-		Timeout: 30 * time.Second,
 		Transport: &http.Transport{
 			DisableKeepAlives: true,
 			TLSClientConfig:   tlsConfig,
+			Proxy:             proxy,
 		},
-	}
+	}, nil
 }
 
 func (client APIClient) PerformRequestWithRetry(ctx context.Context, method string, path string, params []QueryParam, body []byte) (*APIResponse, error) {
+	if client.ReadOnly && method != "GET" {
+		return nil, &APIError{
+			Err:     fmt.Errorf("refused %s %s", method, path),
+			Message: "The provider is configured with `read_only = true`, so it cannot modify Doppler resources",
+		}
+	}
+
 	var lastErr error
+	refreshedAPIKey := false
 	for i := 0; i < MAX_RETRIES; i++ {
+		if client.OIDCSession != nil {
+			apiKey, err := client.OIDCSession.APIKey(ctx)
+			if err != nil {
+				return nil, &APIError{Err: err, Message: "Unable to refresh OIDC API token"}
+			}
+			client.APIKey = apiKey
+		}
+
 		url := fmt.Sprintf("%s%s", client.Host, path)
 		var bodyReader io.Reader
 		if body != nil {
 			bodyReader = bytes.NewReader(body)
 		}
-		req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+		requestCtx, cancel := requestContext(ctx)
+		req, err := http.NewRequestWithContext(requestCtx, method, url, bodyReader)
 		if err != nil {
+			cancel()
 			return nil, &APIError{Err: err, Message: "Unable to form request"}
 		}
 
 		response, err := client.PerformRequest(req, params)
+		cancel()
 		lastErr = err
 		if err == nil {
 			return response, nil
 		}
+		// Retrying is pointless once the operation has timed out
+		if ctx.Err() != nil {
+			return nil, typedAPIError(err)
+		}
 		apiError, isAPIError := err.(*APIError)
+		// The OIDC API token may have been revoked or expired early, so it is refreshed once before giving up
+		if isAPIError && client.OIDCSession != nil && !refreshedAPIKey && apiError.Response != nil && apiError.Response.HTTPResponse.StatusCode == 401 {
+			client.OIDCSession.Invalidate(client.APIKey)
+			refreshedAPIKey = true
+			continue
+		}
 		if !isAPIError || apiError.RetryAfter == nil {
-			return nil, err
+			return nil, typedAPIError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, typedAPIError(err)
+		case <-time.After(*apiError.RetryAfter):
 		}
-		time.Sleep(*apiError.RetryAfter)
 	}
-	return nil, lastErr
+	return nil, typedAPIError(lastErr)
 }
 
-func (client APIClient) PerformRequest(req *http.Request, params []QueryParam) (*APIResponse, error) {
-	httpClient := httpClientWithTLSConfig(client.VerifyTLS)
+// requestContext bounds a single request attempt by defaultRequestTimeout (or the timeout set with withRequestTimeout),
+// so that a stalled connection is retried, and by the deadline of the operation making it (e.g. a resource's `timeouts`).
+// Requests made with withOperationTimeout are only bounded by the operation's deadline.
+func requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := defaultRequestTimeout
+	if requestTimeout, ok := ctx.Value(requestTimeoutKey{}).(time.Duration); ok {
+		timeout = requestTimeout
+	}
+	if timeout == 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// withRequestTimeout changes the timeout of each request attempt made with the returned context.
+func withRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey{}, timeout)
+}
 
+// withOperationTimeout removes the timeout of each request attempt made with the returned context,
+// leaving them bounded by the deadline of the operation alone (i.e. the `timeouts` the user configured).
+func withOperationTimeout(ctx context.Context) context.Context {
+	return withRequestTimeout(ctx, 0)
+}
+
+func (client APIClient) PerformRequest(req *http.Request, params []QueryParam) (*APIResponse, error) {
 	userAgent := fmt.Sprintf("terraform-provider-doppler/%s", ProviderVersion)
 	req.Header.Set("user-agent", userAgent)
 	req.SetBasicAuth(client.APIKey, "")
@@ -130,10 +325,11 @@ func (client APIClient) PerformRequest(req *http.Request, params []QueryParam) (
 	}
 	req.URL.RawQuery = query.Encode()
 
-	r, err := httpClient.Do(req)
+	r, err := client.HTTPClient.Do(req)
 	if err != nil {
 		var retryAfter *time.Duration
-		if e, ok := err.(net.Error); ok && e.Timeout() {
+		// Only GETs are retried after timing out, anything else may have been applied (e.g. creating a duplicate)
+		if e, ok := err.(net.Error); ok && e.Timeout() && req.Method == "GET" {
 			retryAfter = getSecondsDuration(1)
 		}
 
@@ -606,6 +802,18 @@ func (client APIClient) DeleteIntegrationMember(ctx context.Context, integration
 
 // Integrations
 
+func (client APIClient) ListIntegrations(ctx context.Context) ([]Integration, error) {
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/integrations", []QueryParam{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result IntegrationsResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse integrations"}
+	}
+	return result.Integrations, nil
+}
+
 func (client APIClient) GetIntegration(ctx context.Context, slug string) (*Integration, error) {
 	params := []QueryParam{
 		{Key: "integration", Value: slug},
@@ -758,6 +966,22 @@ func (client APIClient) GetRotatedSecret(ctx context.Context, config, project, s
 	return &result.RotatedSecret, nil
 }
 
+func (client APIClient) ListRotatedSecrets(ctx context.Context, config, project string) ([]RotatedSecret, error) {
+	params := []QueryParam{
+		{Key: "config", Value: config},
+		{Key: "project", Value: project},
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/configs/config/rotated_secrets", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result RotatedSecretsResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse rotated secrets"}
+	}
+	return result.RotatedSecrets, nil
+}
+
 func (client APIClient) CreateExternalId(ctx context.Context, integrationType string) (string, error) {
 	payload := map[string]interface{}{
 		"type": integrationType,
@@ -777,7 +1001,7 @@ func (client APIClient) CreateExternalId(ctx context.Context, integrationType st
 	return result.ExternalId, nil
 }
 
-func (client APIClient) CreateRotatedSecret(ctx context.Context, name string, rotationPeriodSec int, parameters RotatedSecretParameters, credentials RotatedSecretCredentials, config, project, integration string) (*RotatedSecret, error) {
+func (client APIClient) CreateRotatedSecret(ctx context.Context, name string, rotationPeriodSec int, parameters RotatedSecretParameters, credentials RotatedSecretCredentials, secretNames RotatedSecretNames, config, project, integration string) (*RotatedSecret, error) {
 	params := []QueryParam{
 		{Key: "config", Value: config},
 		{Key: "project", Value: project},
@@ -789,6 +1013,9 @@ func (client APIClient) CreateRotatedSecret(ctx context.Context, name string, ro
 		"parameters":          parameters,
 		"credentials":         credentials,
 	}
+	if len(secretNames) > 0 {
+		payload["secret_names"] = secretNames
+	}
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return nil, &APIError{Err: err, Message: "Unable to serialize rotated secret"}
@@ -829,6 +1056,23 @@ func (client APIClient) UpdateRotatedSecret(ctx context.Context, name string, ro
 	return &result.RotatedSecret, nil
 }
 
+func (client APIClient) RotateRotatedSecret(ctx context.Context, config, project, slug string) (*RotatedSecret, error) {
+	params := []QueryParam{
+		{Key: "config", Value: config},
+		{Key: "project", Value: project},
+		{Key: "slug", Value: slug},
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "POST", "/v3/configs/config/rotated_secrets/rotated_secret/rotate", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result RotatedSecretResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse rotated secret"}
+	}
+	return &result.RotatedSecret, nil
+}
+
 func (client APIClient) DeleteRotatedSecret(ctx context.Context, slug string, config, project string) error {
 	params := []QueryParam{
 		{Key: "config", Value: config},
@@ -842,6 +1086,136 @@ func (client APIClient) DeleteRotatedSecret(ctx context.Context, slug string, co
 	return nil
 }
 
+// Dynamic Secrets
+
+func (client APIClient) GetDynamicSecret(ctx context.Context, config, project, slug string) (*DynamicSecret, error) {
+	params := []QueryParam{
+		{Key: "config", Value: config},
+		{Key: "project", Value: project},
+		{Key: "slug", Value: slug},
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/configs/config/dynamic_secrets/dynamic_secret", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result DynamicSecretResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse dynamic secret"}
+	}
+	return &result.DynamicSecret, nil
+}
+
+func (client APIClient) CreateDynamicSecret(ctx context.Context, name string, defaultTtlSec int, maxTtlSec int, parameters DynamicSecretParameters, config, project, integration string) (*DynamicSecret, error) {
+	params := []QueryParam{
+		{Key: "config", Value: config},
+		{Key: "project", Value: project},
+	}
+	payload := map[string]interface{}{
+		"integration":     integration,
+		"name":            name,
+		"default_ttl_sec": defaultTtlSec,
+		"parameters":      parameters,
+	}
+	if maxTtlSec != 0 {
+		payload["max_ttl_sec"] = maxTtlSec
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to serialize dynamic secret"}
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "POST", "/v3/configs/config/dynamic_secrets", params, body)
+	if err != nil {
+		return nil, err
+	}
+	var result DynamicSecretResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse dynamic secret"}
+	}
+	return &result.DynamicSecret, nil
+}
+
+func (client APIClient) UpdateDynamicSecret(ctx context.Context, name string, defaultTtlSec int, maxTtlSec int, config, project, slug string) (*DynamicSecret, error) {
+	params := []QueryParam{
+		{Key: "config", Value: config},
+		{Key: "project", Value: project},
+		{Key: "slug", Value: slug},
+	}
+	payload := map[string]interface{}{
+		"name":            name,
+		"default_ttl_sec": defaultTtlSec,
+	}
+	if maxTtlSec != 0 {
+		payload["max_ttl_sec"] = maxTtlSec
+	} else {
+		payload["max_ttl_sec"] = nil
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to serialize dynamic secret"}
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "PUT", "/v3/configs/config/dynamic_secrets/dynamic_secret", params, body)
+	if err != nil {
+		return nil, err
+	}
+	var result DynamicSecretResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse dynamic secret"}
+	}
+	return &result.DynamicSecret, nil
+}
+
+func (client APIClient) DeleteDynamicSecret(ctx context.Context, slug string, config, project string) error {
+	params := []QueryParam{
+		{Key: "config", Value: config},
+		{Key: "project", Value: project},
+		{Key: "slug", Value: slug},
+	}
+	_, err := client.PerformRequestWithRetry(ctx, "DELETE", "/v3/configs/config/dynamic_secrets/dynamic_secret", params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (client APIClient) IssueDynamicSecretLease(ctx context.Context, config, project, dynamicSecret string, ttlSec int) (*DynamicSecretLease, error) {
+	params := []QueryParam{
+		{Key: "config", Value: config},
+		{Key: "project", Value: project},
+		{Key: "dynamic_secret", Value: dynamicSecret},
+	}
+	payload := map[string]interface{}{}
+	if ttlSec != 0 {
+		payload["ttl_sec"] = ttlSec
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to serialize dynamic secret lease"}
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "POST", "/v3/configs/config/dynamic_secrets/dynamic_secret/leases", params, body)
+	if err != nil {
+		return nil, err
+	}
+	var result DynamicSecretLeaseResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse dynamic secret lease"}
+	}
+	return &result.Lease, nil
+}
+
+func (client APIClient) RevokeDynamicSecretLease(ctx context.Context, config, project, dynamicSecret, slug string) error {
+	params := []QueryParam{
+		{Key: "config", Value: config},
+		{Key: "project", Value: project},
+		{Key: "dynamic_secret", Value: dynamicSecret},
+		{Key: "slug", Value: slug},
+	}
+	_, err := client.PerformRequestWithRetry(ctx, "DELETE", "/v3/configs/config/dynamic_secrets/dynamic_secret/leases/lease", params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // Environments
 
 func (client APIClient) GetEnvironment(ctx context.Context, project string, name string) (*Environment, error) {
@@ -959,6 +1333,7 @@ type CreateWebhookOptionalParameters struct {
 	Auth           *WebhookAuth
 	WebhookPayload string
 	EnabledConfigs []string
+	Events         []string
 	Name           string
 }
 
@@ -985,6 +1360,9 @@ func (client APIClient) CreateWebhook(ctx context.Context, project string, url s
 		if options.EnabledConfigs != nil {
 			payload["enableConfigs"] = options.EnabledConfigs
 		}
+		if options.Events != nil {
+			payload["events"] = options.Events
+		}
 		if options.Name != "" {
 			payload["name"] = options.Name
 		}
@@ -1039,23 +1417,59 @@ func (client APIClient) DisableWebhook(ctx context.Context, project string, slug
 	return &result.Webhook, nil
 }
 
-func (client APIClient) UpdateWebhook(ctx context.Context, project string, slug string, webhookUrl string, secret string, webhookPayload string, webhookName string, enabledConfigs []string, disabledConfigs []string, auth WebhookAuth) (*Webhook, error) {
+type UpdateWebhookOptionalParameters struct {
+	Url             *string
+	Secret          *string
+	WebhookPayload  *string
+	Name            *string
+	EnabledConfigs  []string
+	DisabledConfigs []string
+	Events          []string
+	Auth            *WebhookAuth
+}
+
+func (client APIClient) UpdateWebhook(ctx context.Context, project string, slug string, options *UpdateWebhookOptionalParameters) (*Webhook, error) {
 	params := []QueryParam{
 		{Key: "project", Value: project},
 	}
 
+	// Only the fields that are set are sent, so that unchanged fields aren't overwritten
 	payload := map[string]interface{}{}
-	payload["url"] = webhookUrl
-	payload["secret"] = secret
-	payload["payload"] = webhookPayload
-	if webhookName != "" {
-		payload["name"] = webhookName
-	} else {
-		payload["name"] = nil
+	if options != nil {
+		if options.Url != nil {
+			payload["url"] = *options.Url
+		}
+		if options.Secret != nil {
+			payload["secret"] = *options.Secret
+		}
+		if options.WebhookPayload != nil {
+			payload["payload"] = *options.WebhookPayload
+		}
+		if options.Name != nil {
+			if *options.Name != "" {
+				payload["name"] = *options.Name
+			} else {
+				payload["name"] = nil
+			}
+		}
+		if options.EnabledConfigs != nil {
+			payload["enableConfigs"] = options.EnabledConfigs
+		}
+		if options.DisabledConfigs != nil {
+			payload["disableConfigs"] = options.DisabledConfigs
+		}
+		if options.Events != nil {
+			// An empty list resets the webhook to trigger on all events
+			if len(options.Events) > 0 {
+				payload["events"] = options.Events
+			} else {
+				payload["events"] = nil
+			}
+		}
+		if options.Auth != nil {
+			payload["authentication"] = *options.Auth
+		}
 	}
-	payload["enableConfigs"] = enabledConfigs
-	payload["disableConfigs"] = disabledConfigs
-	payload["authentication"] = auth
 
 	body, err := json.Marshal(payload)
 	if err != nil {
@@ -1074,6 +1488,21 @@ func (client APIClient) UpdateWebhook(ctx context.Context, project string, slug
 	return &result.Webhook, nil
 }
 
+func (client APIClient) ListWebhookDeliveries(ctx context.Context, project string, slug string) ([]WebhookDelivery, error) {
+	params := []QueryParam{
+		{Key: "project", Value: project},
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "GET", fmt.Sprintf("/v3/webhooks/webhook/%s/deliveries", url.QueryEscape(slug)), params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result WebhookDeliveriesResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse webhook deliveries"}
+	}
+	return result.Deliveries, nil
+}
+
 func (client APIClient) DeleteWebhook(ctx context.Context, project string, slug string) error {
 	params := []QueryParam{
 		{Key: "project", Value: project},
@@ -1230,13 +1659,16 @@ func (client APIClient) GetServiceTokens(ctx context.Context, project string, co
 	return result.ServiceTokens, nil
 }
 
-func (client APIClient) CreateServiceToken(ctx context.Context, project string, config string, access string, name string) (*ServiceToken, error) {
+func (client APIClient) CreateServiceToken(ctx context.Context, project string, config string, access string, name string, expireAt string) (*ServiceToken, error) {
 	payload := map[string]interface{}{
 		"project": project,
 		"config":  config,
 		"access":  access,
 		"name":    name,
 	}
+	if expireAt != "" {
+		payload["expire_at"] = expireAt
+	}
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return nil, &APIError{Err: err, Message: "Unable to serialize service token"}
@@ -1284,6 +1716,22 @@ func (client APIClient) GetServiceAccount(ctx context.Context, slug string) (*Se
 	return &result.ServiceAccount, nil
 }
 
+func (client APIClient) ListServiceAccounts(ctx context.Context, pageOptions PageOptions) ([]ServiceAccount, error) {
+	params := []QueryParam{
+		{Key: "page", Value: strconv.Itoa(pageOptions.Page)},
+		{Key: "per_page", Value: strconv.Itoa(pageOptions.PerPage)},
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/workplace/service_accounts", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result ServiceAccountsResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse service accounts"}
+	}
+	return result.ServiceAccounts, nil
+}
+
 func (client APIClient) CreateServiceAccount(ctx context.Context, name string, workplaceRole string, workplacePermissions []string) (*ServiceAccount, error) {
 	payload := map[string]interface{}{
 		"name": name,
@@ -1455,6 +1903,22 @@ func (client APIClient) GetGroup(ctx context.Context, slug string) (*Group, erro
 	return &result.Group, nil
 }
 
+func (client APIClient) ListGroups(ctx context.Context, pageOptions PageOptions) ([]Group, error) {
+	params := []QueryParam{
+		{Key: "page", Value: strconv.Itoa(pageOptions.Page)},
+		{Key: "per_page", Value: strconv.Itoa(pageOptions.PerPage)},
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/workplace/groups", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result GroupsResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse groups"}
+	}
+	return result.Groups, nil
+}
+
 func (client APIClient) GetGroupByName(ctx context.Context, name string) (*Group, error) {
 	params := []QueryParam{
 		{Key: "name", Value: name},
@@ -1627,6 +2091,81 @@ func (client APIClient) GetWorkplaceUser(ctx context.Context, email string) (*Wo
 	return &result.WorkplaceUsers[0], nil
 }
 
+func (client APIClient) ListWorkplaceUsers(ctx context.Context, pageOptions PageOptions) ([]WorkplaceUser, error) {
+	params := []QueryParam{
+		{Key: "page", Value: strconv.Itoa(pageOptions.Page)},
+		{Key: "per_page", Value: strconv.Itoa(pageOptions.PerPage)},
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/workplace/users", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result WorkplaceUsersListResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse workplace users"}
+	}
+	return result.WorkplaceUsers, nil
+}
+
+func (client APIClient) GetWorkplaceUserBySlug(ctx context.Context, slug string) (*WorkplaceUser, error) {
+	response, err := client.PerformRequestWithRetry(ctx, "GET", fmt.Sprintf("/v3/workplace/users/%s", url.QueryEscape(slug)), []QueryParam{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result WorkplaceUserResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse workplace user"}
+	}
+	return &result.WorkplaceUser, nil
+}
+
+func (client APIClient) InviteWorkplaceUser(ctx context.Context, email string, workplaceRole string) (*WorkplaceUser, error) {
+	payload := map[string]interface{}{
+		"email":          email,
+		"workplace_role": workplaceRole,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to serialize workplace user"}
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "POST", "/v3/workplace/users", []QueryParam{}, body)
+	if err != nil {
+		return nil, err
+	}
+	var result WorkplaceUserResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse workplace user"}
+	}
+	return &result.WorkplaceUser, nil
+}
+
+func (client APIClient) UpdateWorkplaceUser(ctx context.Context, slug string, workplaceRole string) (*WorkplaceUser, error) {
+	payload := map[string]interface{}{
+		"workplace_role": workplaceRole,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to serialize workplace user"}
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "PATCH", fmt.Sprintf("/v3/workplace/users/%s", url.QueryEscape(slug)), []QueryParam{}, body)
+	if err != nil {
+		return nil, err
+	}
+	var result WorkplaceUserResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse workplace user"}
+	}
+	return &result.WorkplaceUser, nil
+}
+
+func (client APIClient) DeleteWorkplaceUser(ctx context.Context, slug string) error {
+	_, err := client.PerformRequestWithRetry(ctx, "DELETE", fmt.Sprintf("/v3/workplace/users/%s", url.QueryEscape(slug)), []QueryParam{}, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // Change Request Policies
 
 func (client APIClient) GetChangeRequestPolicy(ctx context.Context, slug string) (*ChangeRequestPolicy, error) {
@@ -1755,25 +2294,29 @@ type oidcAuthRequest struct {
 }
 
 type oidcAuthResponse struct {
-	Token string `json:"token"`
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at"`
 }
 
 const maxOIDCResponseBytes = 1 << 20 // 1MB
 
-func exchangeOIDCToken(ctx context.Context, host string, identity string, jwt string, verifyTLS bool) (string, error) {
+func exchangeOIDCToken(ctx context.Context, httpClient *http.Client, host string, identity string, jwt string) (*oidcAuthResponse, error) {
 	payload := oidcAuthRequest{
 		Identity: identity,
 		Token:    jwt,
 	}
 	body, err := json.Marshal(payload)
 	if err != nil {
-		return "", fmt.Errorf("unable to serialize OIDC auth request: %w", err)
+		return nil, fmt.Errorf("unable to serialize OIDC auth request: %w", err)
 	}
 
+	requestCtx, cancel := requestContext(ctx)
+	defer cancel()
+
 	requestUrl := fmt.Sprintf("%s/v3/auth/oidc", host)
-	req, err := http.NewRequestWithContext(ctx, "POST", requestUrl, bytes.NewReader(body))
+	req, err := http.NewRequestWithContext(requestCtx, "POST", requestUrl, bytes.NewReader(body))
 	if err != nil {
-		return "", fmt.Errorf("unable to create OIDC auth request: %w", err)
+		return nil, fmt.Errorf("unable to create OIDC auth request: %w", err)
 	}
 
 	userAgent := fmt.Sprintf("terraform-provider-doppler/%s", ProviderVersion)
@@ -1781,37 +2324,95 @@ func exchangeOIDCToken(ctx context.Context, host string, identity string, jwt st
 	req.Header.Set("accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
 
-	httpClient := httpClientWithTLSConfig(verifyTLS)
-
 	r, err := httpClient.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("OIDC auth request failed: %w", err)
+		return nil, fmt.Errorf("OIDC auth request failed: %w", err)
 	}
 	defer r.Body.Close()
 
 	responseBody, err := io.ReadAll(io.LimitReader(r.Body, maxOIDCResponseBytes))
 	if err != nil {
-		return "", fmt.Errorf("unable to read OIDC auth response: %w", err)
+		return nil, fmt.Errorf("unable to read OIDC auth response: %w", err)
 	}
 
 	if !isSuccess(r.StatusCode) {
 		var errResponse ErrorResponse
 		if jsonErr := json.Unmarshal(responseBody, &errResponse); jsonErr == nil && len(errResponse.Messages) > 0 {
-			return "", fmt.Errorf("OIDC auth failed (HTTP %d): %s", r.StatusCode, strings.Join(errResponse.Messages, "; "))
+			return nil, fmt.Errorf("OIDC auth failed (HTTP %d): %s", r.StatusCode, strings.Join(errResponse.Messages, "; "))
 		}
-		return "", fmt.Errorf("OIDC auth failed with HTTP %d", r.StatusCode)
+		return nil, fmt.Errorf("OIDC auth failed with HTTP %d", r.StatusCode)
 	}
 
 	var result oidcAuthResponse
 	if err := json.Unmarshal(responseBody, &result); err != nil {
-		return "", fmt.Errorf("unable to parse OIDC auth response: %w", err)
+		return nil, fmt.Errorf("unable to parse OIDC auth response: %w", err)
 	}
 
 	if result.Token == "" {
-		return "", fmt.Errorf("OIDC auth response did not contain a token")
+		return nil, fmt.Errorf("OIDC auth response did not contain a token")
+	}
+
+	return &result, nil
+}
+
+// How long before its expiry an OIDC API token is proactively refreshed
+const oidcRefreshSkew = 30 * time.Second
+
+// OIDCSession holds the API token exchanged for an OIDC token, and exchanges a new OIDC token once it expires.
+// It is shared by all copies of the APIClient, so it is safe for concurrent use.
+type OIDCSession struct {
+	Host       string
+	Identity   string
+	HTTPClient *http.Client
+	// Returns a current OIDC token, e.g. by re-reading a rotated token file
+	GetOIDCToken func(ctx context.Context) (string, error)
+
+	mutex     sync.Mutex
+	apiKey    string
+	expiresAt time.Time
+}
+
+// APIKey returns the current API token, exchanging a new one if it has expired or was invalidated.
+func (session *OIDCSession) APIKey(ctx context.Context) (string, error) {
+	session.mutex.Lock()
+	defer session.mutex.Unlock()
+
+	if session.apiKey != "" && (session.expiresAt.IsZero() || time.Now().Add(oidcRefreshSkew).Before(session.expiresAt)) {
+		return session.apiKey, nil
+	}
+
+	jwt, err := session.GetOIDCToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	result, err := exchangeOIDCToken(ctx, session.HTTPClient, session.Host, session.Identity, jwt)
+	if err != nil {
+		return "", err
 	}
 
-	return result.Token, nil
+	session.update(result)
+	return session.apiKey, nil
+}
+
+// update stores the API token of an OIDC exchange. The caller must hold the mutex unless the session isn't shared yet.
+func (session *OIDCSession) update(result *oidcAuthResponse) {
+	session.apiKey = result.Token
+	// Without a (parseable) expiry, the token is only refreshed once it's rejected
+	session.expiresAt = time.Time{}
+	if expiresAt, err := time.Parse(time.RFC3339, result.ExpiresAt); err == nil {
+		session.expiresAt = expiresAt
+	}
+}
+
+// Invalidate discards `apiKey` so that the next call to APIKey exchanges a new token.
+// Requests that were rejected with an API token that has since been replaced don't trigger another exchange.
+func (session *OIDCSession) Invalidate(apiKey string) {
+	session.mutex.Lock()
+	defer session.mutex.Unlock()
+
+	if session.apiKey == apiKey {
+		session.apiKey = ""
+	}
 }
 
 // Trusted IPs
@@ -1862,10 +2463,223 @@ func (client APIClient) DeleteTrustedIP(ctx context.Context, project, config, ip
 	}
 	_, err = client.PerformRequestWithRetry(ctx, "DELETE", "/v3/configs/config/trusted_ips", params, body)
 	if err != nil {
-		if apiError, ok := err.(*APIError); ok && apiError.Response != nil && apiError.Response.HTTPResponse.StatusCode == 404 {
+		if isNotFoundError(err) {
 			return nil
 		}
 		return err
 	}
 	return nil
 }
+
+// Audit Log Streams
+
+type AuditLogStreamOptionalParameters struct {
+	Name    *string
+	Url     *string
+	Token   *string
+	Headers map[string]string
+	Enabled *bool
+}
+
+func (client APIClient) GetAuditLogStream(ctx context.Context, slug string) (*AuditLogStream, error) {
+	response, err := client.PerformRequestWithRetry(ctx, "GET", fmt.Sprintf("/v3/workplace/audit_log_streams/audit_log_stream/%s", url.QueryEscape(slug)), []QueryParam{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result AuditLogStreamResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse audit log stream"}
+	}
+	return &result.Stream, nil
+}
+
+func (client APIClient) CreateAuditLogStream(ctx context.Context, streamType string, options *AuditLogStreamOptionalParameters) (*AuditLogStream, error) {
+	payload := auditLogStreamPayload(options)
+	payload["type"] = streamType
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to serialize audit log stream"}
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "POST", "/v3/workplace/audit_log_streams", []QueryParam{}, body)
+	if err != nil {
+		return nil, err
+	}
+	var result AuditLogStreamResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse audit log stream"}
+	}
+	return &result.Stream, nil
+}
+
+func (client APIClient) UpdateAuditLogStream(ctx context.Context, slug string, options *AuditLogStreamOptionalParameters) (*AuditLogStream, error) {
+	body, err := json.Marshal(auditLogStreamPayload(options))
+	if err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to serialize audit log stream"}
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "PATCH", fmt.Sprintf("/v3/workplace/audit_log_streams/audit_log_stream/%s", url.QueryEscape(slug)), []QueryParam{}, body)
+	if err != nil {
+		return nil, err
+	}
+	var result AuditLogStreamResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse audit log stream"}
+	}
+	return &result.Stream, nil
+}
+
+func (client APIClient) DeleteAuditLogStream(ctx context.Context, slug string) error {
+	_, err := client.PerformRequestWithRetry(ctx, "DELETE", fmt.Sprintf("/v3/workplace/audit_log_streams/audit_log_stream/%s", url.QueryEscape(slug)), []QueryParam{}, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func auditLogStreamPayload(options *AuditLogStreamOptionalParameters) map[string]interface{} {
+	payload := map[string]interface{}{}
+	if options == nil {
+		return payload
+	}
+	if options.Name != nil {
+		payload["name"] = *options.Name
+	}
+	if options.Url != nil {
+		payload["url"] = *options.Url
+	}
+	if options.Token != nil {
+		payload["token"] = *options.Token
+	}
+	if options.Headers != nil {
+		payload["headers"] = options.Headers
+	}
+	if options.Enabled != nil {
+		payload["enabled"] = *options.Enabled
+	}
+	return payload
+}
+
+// Audit Logs
+
+type AuditLogFilters struct {
+	Project string
+	Start   string
+	End     string
+}
+
+func (client APIClient) ListAuditLogs(ctx context.Context, filters AuditLogFilters, pageOptions PageOptions) ([]AuditLog, error) {
+	params := []QueryParam{
+		{Key: "page", Value: strconv.Itoa(pageOptions.Page)},
+		{Key: "per_page", Value: strconv.Itoa(pageOptions.PerPage)},
+	}
+	if filters.Project != "" {
+		params = append(params, QueryParam{Key: "project", Value: filters.Project})
+	}
+	if filters.Start != "" {
+		params = append(params, QueryParam{Key: "start", Value: filters.Start})
+	}
+	if filters.End != "" {
+		params = append(params, QueryParam{Key: "end", Value: filters.End})
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/logs", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result AuditLogsResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse audit logs"}
+	}
+	return result.Logs, nil
+}
+
+// Token Info
+
+func (client APIClient) GetTokenInfo(ctx context.Context) (*TokenInfo, error) {
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/me", []QueryParam{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result TokenInfo
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse token info"}
+	}
+	return &result, nil
+}
+
+// Workplace Settings
+
+func (client APIClient) GetWorkplaceSettings(ctx context.Context) (*WorkplaceSettings, error) {
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/workplace", []QueryParam{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result WorkplaceSettingsResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse workplace settings"}
+	}
+	return &result.Workplace, nil
+}
+
+func (client APIClient) UpdateWorkplaceSettings(ctx context.Context, settings map[string]interface{}) (*WorkplaceSettings, error) {
+	body, err := json.Marshal(settings)
+	if err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to serialize workplace settings"}
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "POST", "/v3/workplace", []QueryParam{}, body)
+	if err != nil {
+		return nil, err
+	}
+	var result WorkplaceSettingsResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse workplace settings"}
+	}
+	return &result.Workplace, nil
+}
+
+// Workplace SSO
+
+func (client APIClient) GetWorkplaceSAML(ctx context.Context) (*WorkplaceSAML, error) {
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/workplace/saml", []QueryParam{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result WorkplaceSAMLResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse workplace SAML settings"}
+	}
+	return &result.SAML, nil
+}
+
+func (client APIClient) UpdateWorkplaceSAML(ctx context.Context, saml *WorkplaceSAML) (*WorkplaceSAML, error) {
+	payload := map[string]interface{}{
+		"enabled":      saml.Enabled,
+		"scim_enabled": saml.SCIMEnabled,
+	}
+	if saml.MetadataXML != "" {
+		payload["metadata_xml"] = saml.MetadataXML
+	}
+	if saml.MetadataURL != "" {
+		payload["metadata_url"] = saml.MetadataURL
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to serialize workplace SAML settings"}
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "PUT", "/v3/workplace/saml", []QueryParam{}, body)
+	if err != nil {
+		return nil, err
+	}
+	var result WorkplaceSAMLResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse workplace SAML settings"}
+	}
+	return &result.SAML, nil
+}
+
+func (client APIClient) DeleteWorkplaceSAML(ctx context.Context) error {
+	_, err := client.PerformRequestWithRetry(ctx, "DELETE", "/v3/workplace/saml", []QueryParam{}, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,398 @@
+package doppler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPerformRequestWithRetryReadOnly(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("content-type", "application/json")
+		w.Write([]byte(`{"success":true}`))
+	}))
+	defer server.Close()
+
+	client := APIClient{Host: server.URL, APIKey: "dp.pt.test", HTTPClient: server.Client(), ReadOnly: true}
+
+	for _, method := range []string{"POST", "PUT", "PATCH", "DELETE"} {
+		t.Run(method, func(t *testing.T) {
+			atomic.StoreInt32(&requests, 0)
+			_, err := client.PerformRequestWithRetry(context.Background(), method, "/v3/projects/project", nil, []byte(`{}`))
+			if err == nil || !strings.Contains(err.Error(), "read_only") {
+				t.Fatalf("got error %v, want a read_only error", err)
+			}
+			if got := atomic.LoadInt32(&requests); got != 0 {
+				t.Errorf("got %d requests to the server, want 0", got)
+			}
+		})
+	}
+
+	t.Run("GET", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		response, err := client.PerformRequestWithRetry(context.Background(), "GET", "/v3/projects/project", nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got := atomic.LoadInt32(&requests); got != 1 {
+			t.Errorf("got %d requests to the server, want 1", got)
+		}
+		if string(response.Body) != `{"success":true}` {
+			t.Errorf("got body %q", response.Body)
+		}
+	})
+}
+
+func TestPerformRequestWithRetryStalledRequest(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first attempt stalls until the client gives up on it
+		if atomic.AddInt32(&requests, 1) == 1 {
+			<-r.Context().Done()
+			return
+		}
+		w.Header().Set("content-type", "application/json")
+		w.Write([]byte(`{"success":true}`))
+	}))
+	defer server.Close()
+
+	client := APIClient{Host: server.URL, APIKey: "dp.pt.test", HTTPClient: server.Client()}
+
+	// The operation's deadline is far longer than the request timeout, as with the SDK's default timeouts
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	ctx = withRequestTimeout(ctx, 100*time.Millisecond)
+
+	if _, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/projects/project", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("got %d requests to the server, want 2", got)
+	}
+}
+
+func TestPerformRequestWithRetryStalledWrite(t *testing.T) {
+	var requests int32
+	stalled := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-stalled
+	}))
+	defer server.Close()
+	defer close(stalled)
+
+	client := APIClient{Host: server.URL, APIKey: "dp.pt.test", HTTPClient: server.Client()}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	ctx = withRequestTimeout(ctx, 100*time.Millisecond)
+
+	// The server may have created the project before the request timed out, so retrying could create it twice
+	if _, err := client.PerformRequestWithRetry(ctx, "POST", "/v3/projects", nil, []byte(`{}`)); err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("got %d requests to the server, want 1", got)
+	}
+}
+
+func TestRequestContext(t *testing.T) {
+	tests := []struct {
+		name             string
+		operationTimeout time.Duration
+		requestTimeout   time.Duration
+		operationOnly    bool
+		want             time.Duration
+	}{
+		{name: "no deadline", want: defaultRequestTimeout},
+		{name: "operation deadline after the request timeout", operationTimeout: 20 * time.Minute, want: defaultRequestTimeout},
+		{name: "operation deadline before the request timeout", operationTimeout: 10 * time.Second, want: 10 * time.Second},
+		{name: "request timeout", operationTimeout: 10 * time.Minute, requestTimeout: 5 * time.Minute, want: 5 * time.Minute},
+		{name: "operation timeout", operationTimeout: 10 * time.Minute, operationOnly: true, want: 10 * time.Minute},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			if test.operationTimeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.operationTimeout)
+				defer cancel()
+			}
+			if test.requestTimeout != 0 {
+				ctx = withRequestTimeout(ctx, test.requestTimeout)
+			}
+			if test.operationOnly {
+				ctx = withOperationTimeout(ctx)
+			}
+
+			requestCtx, cancel := requestContext(ctx)
+			defer cancel()
+			deadline, ok := requestCtx.Deadline()
+			if !ok {
+				t.Fatal("request context has no deadline")
+			}
+			if got := time.Until(deadline); got > test.want || got < test.want-time.Second {
+				t.Errorf("got a deadline in %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestTypedAPIError(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		check      func(error) bool
+	}{
+		{statusCode: 400, check: isValidationError},
+		{statusCode: 422, check: isValidationError},
+		{statusCode: 401, check: isUnauthorizedError},
+		{statusCode: 403, check: isForbiddenError},
+		{statusCode: 404, check: isNotFoundError},
+		{statusCode: 409, check: isConflictError},
+		{statusCode: 429, check: isRateLimitedError},
+		{statusCode: 500, check: isServerError},
+		{statusCode: 503, check: isServerError},
+	}
+	for _, test := range tests {
+		t.Run(http.StatusText(test.statusCode), func(t *testing.T) {
+			apiError := &APIError{
+				Message:  "failed",
+				Response: &APIResponse{HTTPResponse: &http.Response{StatusCode: test.statusCode}, Body: []byte(`{}`)},
+			}
+			err := typedAPIError(apiError)
+			if !test.check(err) {
+				t.Errorf("got %T for HTTP %d", err, test.statusCode)
+			}
+			// The typed error still unwraps to the APIError
+			var unwrapped *APIError
+			if !errors.As(err, &unwrapped) || unwrapped != apiError {
+				t.Errorf("typed error doesn't unwrap to the APIError")
+			}
+		})
+	}
+
+	t.Run("untyped status", func(t *testing.T) {
+		apiError := &APIError{Response: &APIResponse{HTTPResponse: &http.Response{StatusCode: 418}}}
+		if err := typedAPIError(apiError); err != error(apiError) {
+			t.Errorf("got %T, want the APIError", err)
+		}
+	})
+
+	t.Run("no response", func(t *testing.T) {
+		apiError := &APIError{Err: errors.New("connection refused"), Message: "Unable to load response"}
+		if err := typedAPIError(apiError); err != error(apiError) {
+			t.Errorf("got %T, want the APIError", err)
+		}
+	})
+
+	t.Run("not an APIError", func(t *testing.T) {
+		err := errors.New("failed")
+		if got := typedAPIError(err); got != err {
+			t.Errorf("got %v, want the original error", got)
+		}
+	})
+}
+
+func TestParseFieldErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []FieldError
+	}{
+		{
+			name: "field errors",
+			body: `{"messages":["Invalid request"],"data":{"errors":[{"field":"name","message":"is required"},{"field":"credentials.0.PASSWORD","message":"is too short"}]}}`,
+			want: []FieldError{{Field: "name", Message: "is required"}, {Field: "credentials.0.PASSWORD", Message: "is too short"}},
+		},
+		{
+			name: "incomplete field errors",
+			body: `{"data":{"errors":[{"field":"name"},{"message":"is required"},{"field":"url","message":"is invalid"}]}}`,
+			want: []FieldError{{Field: "url", Message: "is invalid"}},
+		},
+		{name: "no field errors", body: `{"messages":["Invalid request"],"success":false}`, want: []FieldError{}},
+		{name: "unexpected errors", body: `{"data":{"errors":"invalid"}}`, want: nil},
+		{name: "malformed", body: `not json`, want: nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseFieldErrors([]byte(test.body))
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %#v, want %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPerformRequestWithRetryValidationError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"messages":["Invalid trusted IP"],"data":{"errors":[{"field":"ip","message":"must be a CIDR range"}]},"success":false}`))
+	}))
+	defer server.Close()
+
+	client := APIClient{Host: server.URL, APIKey: "dp.pt.test", HTTPClient: server.Client()}
+	_, err := client.PerformRequestWithRetry(context.Background(), "POST", "/v3/configs/config/trusted_ips", nil, []byte(`{}`))
+
+	var validationError *ValidationError
+	if !errors.As(err, &validationError) {
+		t.Fatalf("got %T, want a ValidationError", err)
+	}
+	if validationError.Message != "Invalid trusted IP" {
+		t.Errorf("got message %q", validationError.Message)
+	}
+	want := []FieldError{{Field: "ip", Message: "must be a CIDR range"}}
+	if !reflect.DeepEqual(validationError.FieldErrors, want) {
+		t.Errorf("got field errors %#v, want %#v", validationError.FieldErrors, want)
+	}
+}
+
+// oidcTestServer serves OIDC exchanges, issuing a new API token for each, and API requests, rejecting any with `rejected` API tokens.
+func oidcTestServer(exchanges *int32, rejected func(apiKey string) bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if r.URL.Path == "/v3/auth/oidc" {
+			exchange := atomic.AddInt32(exchanges, 1)
+			// Concurrent callers have to wait on the same exchange
+			time.Sleep(20 * time.Millisecond)
+			fmt.Fprintf(w, `{"success":true,"token":"dp.sa.%d","expires_at":%q}`, exchange, time.Now().Add(time.Hour).Format(time.RFC3339))
+			return
+		}
+		apiKey, _, _ := r.BasicAuth()
+		if rejected(apiKey) {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"success":false,"messages":["Invalid token"]}`))
+			return
+		}
+		w.Write([]byte(`{"success":true}`))
+	}))
+}
+
+func oidcTestSession(server *httptest.Server) *OIDCSession {
+	return &OIDCSession{
+		Host:       server.URL,
+		Identity:   "00000000-0000-0000-0000-000000000000",
+		HTTPClient: server.Client(),
+		GetOIDCToken: func(ctx context.Context) (string, error) {
+			return "header.payload.signature", nil
+		},
+	}
+}
+
+func TestOIDCSessionRefreshBeforeExpiry(t *testing.T) {
+	tests := []struct {
+		name        string
+		expiresIn   time.Duration
+		noExpiry    bool
+		wantRefresh bool
+	}{
+		{name: "before the refresh skew", expiresIn: oidcRefreshSkew + time.Minute, wantRefresh: false},
+		{name: "within the refresh skew", expiresIn: oidcRefreshSkew - time.Second, wantRefresh: true},
+		{name: "expired", expiresIn: -time.Minute, wantRefresh: true},
+		{name: "no expiry", noExpiry: true, wantRefresh: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var exchanges int32
+			server := oidcTestServer(&exchanges, func(apiKey string) bool { return false })
+			defer server.Close()
+
+			session := oidcTestSession(server)
+			session.apiKey = "dp.sa.initial"
+			if !test.noExpiry {
+				session.expiresAt = time.Now().Add(test.expiresIn)
+			}
+
+			apiKey, err := session.APIKey(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			wantAPIKey, wantExchanges := "dp.sa.initial", int32(0)
+			if test.wantRefresh {
+				wantAPIKey, wantExchanges = "dp.sa.1", 1
+			}
+			if apiKey != wantAPIKey {
+				t.Errorf("got API key %q, want %q", apiKey, wantAPIKey)
+			}
+			if got := atomic.LoadInt32(&exchanges); got != wantExchanges {
+				t.Errorf("got %d exchanges, want %d", got, wantExchanges)
+			}
+		})
+	}
+}
+
+func TestPerformRequestWithRetryOIDCUnauthorized(t *testing.T) {
+	t.Run("refreshed token accepted", func(t *testing.T) {
+		var exchanges int32
+		server := oidcTestServer(&exchanges, func(apiKey string) bool { return apiKey == "dp.sa.1" })
+		defer server.Close()
+
+		client := APIClient{Host: server.URL, HTTPClient: server.Client(), OIDCSession: oidcTestSession(server)}
+		if _, err := client.PerformRequestWithRetry(context.Background(), "GET", "/v3/me", nil, nil); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got := atomic.LoadInt32(&exchanges); got != 2 {
+			t.Errorf("got %d exchanges, want 2", got)
+		}
+	})
+
+	t.Run("refreshed token rejected", func(t *testing.T) {
+		var exchanges int32
+		server := oidcTestServer(&exchanges, func(apiKey string) bool { return true })
+		defer server.Close()
+
+		client := APIClient{Host: server.URL, HTTPClient: server.Client(), OIDCSession: oidcTestSession(server)}
+		_, err := client.PerformRequestWithRetry(context.Background(), "GET", "/v3/me", nil, nil)
+		if !isUnauthorizedError(err) {
+			t.Fatalf("got error %v, want an unauthorized error", err)
+		}
+		// The token is only refreshed once
+		if got := atomic.LoadInt32(&exchanges); got != 2 {
+			t.Errorf("got %d exchanges, want 2", got)
+		}
+	})
+}
+
+func TestOIDCSessionConcurrentRefresh(t *testing.T) {
+	var exchanges int32
+	server := oidcTestServer(&exchanges, func(apiKey string) bool { return apiKey == "dp.sa.stale" })
+	defer server.Close()
+
+	// All requests are made with the stale token, which is rejected, and must share a single exchange for a new one
+	session := oidcTestSession(server)
+	session.apiKey = "dp.sa.stale"
+	session.expiresAt = time.Now().Add(time.Hour)
+	client := APIClient{Host: server.URL, HTTPClient: server.Client(), OIDCSession: session}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.PerformRequestWithRetry(context.Background(), "GET", "/v3/me", nil, nil)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	if got := atomic.LoadInt32(&exchanges); got != 1 {
+		t.Errorf("got %d exchanges, want 1", got)
+	}
+}
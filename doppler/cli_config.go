@@ -0,0 +1,85 @@
+package doppler
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The settings of a directory scope in the Doppler CLI configuration (e.g. as saved by `doppler login --scope`)
+type cliScopedOptions struct {
+	Token     string `yaml:"token"`
+	APIHost   string `yaml:"api-host"`
+	VerifyTLS string `yaml:"verify-tls"`
+}
+
+type cliConfig struct {
+	Scoped map[string]cliScopedOptions `yaml:"scoped"`
+}
+
+// cliConfigPath returns the location of the Doppler CLI configuration, which can be moved with DOPPLER_CONFIG_DIR like the CLI.
+func cliConfigPath() (string, error) {
+	configDir := os.Getenv("DOPPLER_CONFIG_DIR")
+	if configDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("unable to determine the home directory: %w", err)
+		}
+		configDir = filepath.Join(home, ".doppler")
+	}
+	return filepath.Join(configDir, ".doppler.yaml"), nil
+}
+
+func loadCLIConfig(path string) (*cliConfig, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var config cliConfig
+	if err := yaml.Unmarshal(contents, &config); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	return &config, nil
+}
+
+// resolve returns the options that apply to `dir`. Like the CLI, each option is taken from the
+// most specific (longest) scope that contains `dir` and sets the option.
+func (config cliConfig) resolve(dir string) cliScopedOptions {
+	scopes := make([]string, 0, len(config.Scoped))
+	for scope := range config.Scoped {
+		if cliScopeContains(scope, dir) {
+			scopes = append(scopes, scope)
+		}
+	}
+	sort.Slice(scopes, func(i, j int) bool {
+		return len(filepath.Clean(scopes[i])) > len(filepath.Clean(scopes[j]))
+	})
+
+	var resolved cliScopedOptions
+	for _, scope := range scopes {
+		options := config.Scoped[scope]
+		if resolved.Token == "" {
+			resolved.Token = options.Token
+		}
+		if resolved.APIHost == "" {
+			resolved.APIHost = options.APIHost
+		}
+		if resolved.VerifyTLS == "" {
+			resolved.VerifyTLS = options.VerifyTLS
+		}
+	}
+	return resolved
+}
+
+func cliScopeContains(scope string, dir string) bool {
+	scope = filepath.Clean(scope)
+	dir = filepath.Clean(dir)
+	if scope == string(filepath.Separator) || scope == dir {
+		return true
+	}
+	return strings.HasPrefix(dir, scope+string(filepath.Separator))
+}
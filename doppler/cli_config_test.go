@@ -0,0 +1,104 @@
+package doppler
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCLIConfigResolve(t *testing.T) {
+	config := cliConfig{
+		Scoped: map[string]cliScopedOptions{
+			"/":                         {Token: "root-token", APIHost: "https://api.doppler.com", VerifyTLS: "true"},
+			"/home/user/work":           {Token: "work-token", APIHost: "https://doppler.example.com"},
+			"/home/user/work/project":   {Token: "project-token"},
+			"/home/user/work/project-b": {VerifyTLS: "false"},
+		},
+	}
+
+	tests := []struct {
+		name string
+		dir  string
+		want cliScopedOptions
+	}{
+		{
+			name: "root fallback",
+			dir:  "/tmp",
+			want: cliScopedOptions{Token: "root-token", APIHost: "https://api.doppler.com", VerifyTLS: "true"},
+		},
+		{
+			name: "exact scope",
+			dir:  "/home/user/work",
+			want: cliScopedOptions{Token: "work-token", APIHost: "https://doppler.example.com", VerifyTLS: "true"},
+		},
+		{
+			name: "nested scope",
+			dir:  "/home/user/work/project/infra",
+			want: cliScopedOptions{Token: "project-token", APIHost: "https://doppler.example.com", VerifyTLS: "true"},
+		},
+		{
+			name: "sibling with a common prefix",
+			dir:  "/home/user/work/project-b",
+			want: cliScopedOptions{Token: "work-token", APIHost: "https://doppler.example.com", VerifyTLS: "false"},
+		},
+		{
+			name: "unclean directory",
+			dir:  "/home/user/work/project/../project/",
+			want: cliScopedOptions{Token: "project-token", APIHost: "https://doppler.example.com", VerifyTLS: "true"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := config.resolve(test.dir); got != test.want {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCLIConfigResolveNoMatch(t *testing.T) {
+	config := cliConfig{
+		Scoped: map[string]cliScopedOptions{
+			"/home/user/work": {Token: "work-token"},
+		},
+	}
+	for _, dir := range []string{"/home/user", "/home/user/workspace", "/opt"} {
+		if got := config.resolve(dir); got != (cliScopedOptions{}) {
+			t.Errorf("%s: got %+v, want no options", dir, got)
+		}
+	}
+	if got := (cliConfig{}).resolve("/home/user"); got != (cliScopedOptions{}) {
+		t.Errorf("empty config: got %+v, want no options", got)
+	}
+}
+
+func TestLoadCLIConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, ".doppler.yaml")
+	contents := "scoped:\n  /:\n    token: dp.pt.root\n    api-host: https://api.doppler.com\n    verify-tls: \"true\"\n"
+	if err := os.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+	config, err := loadCLIConfig(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := cliScopedOptions{Token: "dp.pt.root", APIHost: "https://api.doppler.com", VerifyTLS: "true"}
+	if got := config.resolve("/home/user"); got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	malformedPath := filepath.Join(dir, "malformed.yaml")
+	if err := os.WriteFile(malformedPath, []byte("scoped:\n  /: [token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadCLIConfig(malformedPath); err == nil || !strings.Contains(err.Error(), "unable to parse") {
+		t.Errorf("got error %v, want a parse error", err)
+	}
+
+	if _, err := loadCLIConfig(filepath.Join(dir, "missing.yaml")); !os.IsNotExist(err) {
+		t.Errorf("got error %v, want a not exist error", err)
+	}
+}
@@ -0,0 +1,146 @@
+package doppler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAuditLogsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	filters := AuditLogFilters{
+		Project: d.Get("project").(string),
+		Start:   d.Get("start").(string),
+		End:     d.Get("end").(string),
+	}
+	maxResults := d.Get("max_results").(int)
+	d.SetId(strings.Join([]string{"audit_logs", filters.Project, filters.Start, filters.End}, "."))
+
+	perPage := 100
+	if maxResults < perPage {
+		perPage = maxResults
+	}
+
+	logs := []AuditLog{}
+	for page := 1; len(logs) < maxResults; page++ {
+		pageLogs, err := client.ListAuditLogs(ctx, filters, PageOptions{Page: page, PerPage: perPage})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		logs = append(logs, pageLogs...)
+		if len(pageLogs) < perPage {
+			break
+		}
+	}
+	if len(logs) > maxResults {
+		logs = logs[:maxResults]
+	}
+
+	// Convert logs to a list of maps for Terraform
+	var logsList []map[string]interface{}
+	for _, log := range logs {
+		logMap := map[string]interface{}{
+			"id":          log.Id,
+			"text":        log.Text,
+			"user_email":  log.User.Email,
+			"user_name":   log.User.Name,
+			"project":     log.Project,
+			"environment": log.Environment,
+			"config":      log.Config,
+			"created_at":  log.CreatedAt,
+		}
+		logsList = append(logsList, logMap)
+	}
+
+	if err := d.Set("list", logsList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceAuditLogs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAuditLogsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "Only return activity for this Doppler project",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"start": {
+				Description:  "Only return activity at or after this time (RFC 3339)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"end": {
+				Description:  "Only return activity before this time (RFC 3339)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"max_results": {
+				Description:  "The maximum number of log entries to return, newest first. Defaults to 100",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 10000),
+			},
+			"list": {
+				Description: "List of activity log entries in the workplace",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the log entry",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"text": {
+							Description: "A description of the activity",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"user_email": {
+							Description: "The email of the user who performed the activity",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"user_name": {
+							Description: "The name of the user who performed the activity",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"project": {
+							Description: "The project the activity applies to, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"environment": {
+							Description: "The environment the activity applies to, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"config": {
+							Description: "The config the activity applies to, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "When the activity occurred",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
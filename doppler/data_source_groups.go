@@ -0,0 +1,156 @@
+package doppler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	includeMembers := d.Get("include_members").(bool)
+
+	perPage := 1000
+	maxPages := 5
+
+	groups := []Group{}
+	for page := 1; page <= maxPages; page++ {
+		pageGroups, err := client.ListGroups(ctx, PageOptions{Page: page, PerPage: perPage})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		groups = append(groups, pageGroups...)
+		if len(pageGroups) < perPage {
+			break
+		} else if page == maxPages {
+			return diag.Errorf("Exceeded max number of groups")
+		}
+	}
+
+	d.SetId("groups")
+
+	// Convert groups to a list of maps for Terraform
+	var groupsList []map[string]interface{}
+	for _, group := range groups {
+		groupMap := map[string]interface{}{
+			"slug":                 group.Slug,
+			"name":                 group.Name,
+			"default_project_role": group.DefaultProjectRole.Identifier,
+			"workplace_role":       group.WorkplaceRole.Identifier,
+			"created_at":           group.CreatedAt,
+		}
+		if includeMembers {
+			members, err := listAllGroupMembers(ctx, client, group.Slug)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			membersList := []map[string]interface{}{}
+			for _, member := range members {
+				membersList = append(membersList, map[string]interface{}{
+					"type": member.Type,
+					"slug": member.Slug,
+				})
+			}
+			groupMap["members"] = membersList
+		}
+		groupsList = append(groupsList, groupMap)
+	}
+
+	if err := d.Set("list", groupsList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// listAllGroupMembers pages through all of the members of a group.
+func listAllGroupMembers(ctx context.Context, client APIClient, group string) ([]GroupMember, error) {
+	perPage := 1000
+	maxPages := 5
+
+	members := []GroupMember{}
+	for page := 1; page <= maxPages; page++ {
+		pageMembers, err := client.GetGroupMembers(ctx, group, PageOptions{Page: page, PerPage: perPage})
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, pageMembers...)
+		if len(pageMembers) < perPage {
+			break
+		} else if page == maxPages {
+			return nil, errors.New("Exceeded max number of group members")
+		}
+	}
+	return members, nil
+}
+
+func dataSourceGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"include_members": {
+				Description: "Whether to resolve the members of each group. This requires additional API requests per group. Defaults to false",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"list": {
+				Description: "List of groups in the workplace",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Description: "The slug of the group",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the group",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"default_project_role": {
+							Description: "The default project role assigned to the group when added to a Doppler project",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"workplace_role": {
+							Description: "The workplace role assigned to members of the group",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "When the group was created",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"members": {
+							Description: "The members of the group. Only populated when `include_members` is true",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Description: "The type of the member (e.g. `workplace_user`)",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"slug": {
+										Description: "The slug of the member",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
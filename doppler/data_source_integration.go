@@ -0,0 +1,100 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIntegrationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	slug := d.Get("slug").(string)
+	name := d.Get("name").(string)
+	integType := d.Get("type").(string)
+
+	integrations, err := client.ListIntegrations(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var matches []Integration
+	for _, integ := range integrations {
+		if slug != "" && integ.Slug != slug {
+			continue
+		}
+		if name != "" && integ.Name != name {
+			continue
+		}
+		if integType != "" && integ.Type != integType {
+			continue
+		}
+		matches = append(matches, integ)
+	}
+
+	if len(matches) == 0 {
+		return diag.Errorf("Could not find a matching integration")
+	}
+	if len(matches) > 1 {
+		return diag.Errorf("Multiple integrations matched, specify `slug`, `name`, and/or `type` to narrow the search")
+	}
+	result := matches[0]
+
+	data, err := flattenIntegrationData(result.Data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(result.Slug)
+	if err := d.Set("slug", result.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", result.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", result.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("data", data); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceIntegration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIntegrationRead,
+		Schema: map[string]*schema.Schema{
+			"slug": {
+				Description:  "The slug of the integration",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"slug", "name", "type"},
+			},
+			"name": {
+				Description:  "The name of the integration",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"slug", "name", "type"},
+			},
+			"type": {
+				Description:  "The type of the integration (e.g. `aws_iam_user_keys`)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"slug", "name", "type"},
+			},
+			"data": {
+				Description: "The non-secret data of the integration. Values that aren't strings are JSON-encoded",
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+			},
+		},
+	}
+}
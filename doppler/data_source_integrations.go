@@ -0,0 +1,117 @@
+package doppler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIntegrationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	integType := d.Get("type").(string)
+
+	integrations, err := client.ListIntegrations(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Convert integrations to a list of maps for Terraform
+	var integrationsList []map[string]interface{}
+	for _, integ := range integrations {
+		if integType != "" && integ.Type != integType {
+			continue
+		}
+		data, err := flattenIntegrationData(integ.Data)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		integrationMap := map[string]interface{}{
+			"slug": integ.Slug,
+			"name": integ.Name,
+			"type": integ.Type,
+			"data": data,
+		}
+		integrationsList = append(integrationsList, integrationMap)
+	}
+
+	if integType != "" {
+		d.SetId(integType)
+	} else {
+		d.SetId("integrations")
+	}
+
+	if err := d.Set("list", integrationsList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// flattenIntegrationData converts the non-secret integration data returned by the API into a string map,
+// JSON-encoding any values that aren't already strings.
+func flattenIntegrationData(data IntegrationData) (map[string]string, error) {
+	result := map[string]string{}
+	for key, value := range data {
+		switch v := value.(type) {
+		case nil:
+			continue
+		case string:
+			result[key] = v
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("Unable to serialize integration data %s: %w", key, err)
+			}
+			result[key] = string(encoded)
+		}
+	}
+	return result, nil
+}
+
+func dataSourceIntegrations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIntegrationsRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Description: "Only return integrations of this type (e.g. `aws_iam_user_keys`)",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"list": {
+				Description: "List of integrations in the workplace",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Description: "The slug of the integration",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the integration",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "The type of the integration",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"data": {
+							Description: "The non-secret data of the integration. Values that aren't strings are JSON-encoded",
+							Type:        schema.TypeMap,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,123 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRotatedSecretsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	project := d.Get("project").(string)
+	config := d.Get("config").(string)
+	d.SetId(getSecretsId(project, config))
+
+	rotatedSecrets, err := client.ListRotatedSecrets(ctx, config, project)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Convert rotated secrets to a list of maps for Terraform
+	var rotatedSecretsList []map[string]interface{}
+	for _, rs := range rotatedSecrets {
+		rotatedSecretMap := map[string]interface{}{
+			"slug":                rs.Slug,
+			"name":                rs.Name,
+			"integration":         rs.Integration.Slug,
+			"integration_type":    rs.Integration.Type,
+			"rotation_period_sec": rs.RotationPeriodSec,
+			"status":              rs.Status,
+			"last_error":          rs.LastError,
+			"last_rotated_at":     rs.LastRotatedAt,
+			"next_rotation_at":    rs.NextRotationAt,
+			"created_at":          rs.CreatedAt,
+		}
+		rotatedSecretsList = append(rotatedSecretsList, rotatedSecretMap)
+	}
+
+	if err := d.Set("list", rotatedSecretsList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceRotatedSecrets() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRotatedSecretsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The name of the Doppler project",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"config": {
+				Description: "The name of the Doppler config",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"list": {
+				Description: "List of rotated secrets in the config",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Description: "The slug of the rotated secret",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the rotated secret",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"integration": {
+							Description: "The slug of the integration used by the rotated secret",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"integration_type": {
+							Description: "The type of the integration used by the rotated secret",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"rotation_period_sec": {
+							Description: "How frequently the secret is rotated",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The rotation status of the secret (e.g. `active` or `failed`)",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_error": {
+							Description: "The error from the most recent failed rotation, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_rotated_at": {
+							Description: "When the secret was last rotated",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"next_rotation_at": {
+							Description: "When the secret is next scheduled to be rotated",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "When the rotated secret was created",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceServiceAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	var result *ServiceAccount
+	slug := d.Get("slug").(string)
+	if slug != "" {
+		serviceAccount, err := client.GetServiceAccount(ctx, slug)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		result = serviceAccount
+	} else {
+		name := d.Get("name").(string)
+		serviceAccounts, err := listAllServiceAccounts(ctx, client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for i, serviceAccount := range serviceAccounts {
+			if serviceAccount.Name != name {
+				continue
+			}
+			if result != nil {
+				return diag.Errorf("Multiple service accounts named %s returned", name)
+			}
+			result = &serviceAccounts[i]
+		}
+		if result == nil {
+			return diag.Errorf("Could not find requested service account %s", name)
+		}
+	}
+
+	d.SetId(result.Slug)
+	if err := updateServiceAccountState(d, result); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created_at", result.CreatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceServiceAccount() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServiceAccountRead,
+		Schema: map[string]*schema.Schema{
+			"slug": {
+				Description:  "The slug of the service account",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"slug", "name"},
+			},
+			"name": {
+				Description:  "The name of the service account",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"slug", "name"},
+			},
+			"workplace_role": {
+				Description: "The identifier of the workplace role for the service account. Empty if the service account uses `workplace_permissions`",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workplace_permissions": {
+				Description: "A list of the workplace permissions for the service account. Only set if the service account doesn't use a `workplace_role`",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"created_at": {
+				Description: "When the service account was created",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceServiceAccountsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	serviceAccounts, err := listAllServiceAccounts(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("service_accounts")
+
+	// Convert service accounts to a list of maps for Terraform
+	var serviceAccountsList []map[string]interface{}
+	for i := range serviceAccounts {
+		serviceAccountMap := flattenServiceAccount(&serviceAccounts[i])
+		serviceAccountMap["created_at"] = serviceAccounts[i].CreatedAt
+		serviceAccountsList = append(serviceAccountsList, serviceAccountMap)
+	}
+
+	if err := d.Set("list", serviceAccountsList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// listAllServiceAccounts pages through all of the service accounts in the workplace.
+func listAllServiceAccounts(ctx context.Context, client APIClient) ([]ServiceAccount, error) {
+	perPage := 1000
+
+	serviceAccounts := []ServiceAccount{}
+	for page := 1; ; page++ {
+		pageServiceAccounts, err := client.ListServiceAccounts(ctx, PageOptions{Page: page, PerPage: perPage})
+		if err != nil {
+			return nil, err
+		}
+		serviceAccounts = append(serviceAccounts, pageServiceAccounts...)
+		if len(pageServiceAccounts) < perPage {
+			return serviceAccounts, nil
+		}
+	}
+}
+
+func dataSourceServiceAccounts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServiceAccountsRead,
+		Schema: map[string]*schema.Schema{
+			"list": {
+				Description: "List of service accounts in the workplace",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Description: "The slug of the service account",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the service account",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"workplace_role": {
+							Description: "The identifier of the workplace role for the service account. Empty if the service account uses inline permissions",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"workplace_permissions": {
+							Description: "The workplace permissions granted to the service account. Only set if the service account doesn't use a `workplace_role`",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"created_at": {
+							Description: "When the service account was created",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
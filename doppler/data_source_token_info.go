@@ -0,0 +1,97 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTokenInfoRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	// Reuse the token info looked up when configuring the provider, if any
+	tokenInfo := client.TokenInfo
+	if tokenInfo == nil {
+		result, err := client.GetTokenInfo(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		tokenInfo = result
+	}
+
+	d.SetId(tokenInfo.Slug)
+
+	values := map[string]interface{}{
+		"slug":           tokenInfo.Slug,
+		"name":           tokenInfo.Name,
+		"type":           tokenInfo.Type,
+		"scope":          tokenInfo.Scope,
+		"token_preview":  tokenInfo.TokenPreview,
+		"created_at":     tokenInfo.CreatedAt,
+		"last_seen_at":   tokenInfo.LastSeenAt,
+		"workplace_slug": tokenInfo.Workplace.Slug,
+		"workplace_name": tokenInfo.Workplace.Name,
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceTokenInfo() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTokenInfoRead,
+		Schema: map[string]*schema.Schema{
+			"slug": {
+				Description: "The slug of the token",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The name of the token",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The type of the token (e.g. `personal`, `service_token`, `service_account` or `cli`)",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"scope": {
+				Description: "The scope of the token, if it is restricted to one",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"token_preview": {
+				Description: "A truncated preview of the token",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "The datetime that the token was created",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_seen_at": {
+				Description: "The datetime that the token was last used",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workplace_slug": {
+				Description: "The slug of the workplace that the token belongs to",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workplace_name": {
+				Description: "The name of the workplace that the token belongs to",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceWebhookDeliveriesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	project := d.Get("project").(string)
+	webhook := d.Get("webhook").(string)
+	d.SetId(webhook)
+
+	deliveries, err := client.ListWebhookDeliveries(ctx, project, webhook)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Convert deliveries to a list of maps for Terraform
+	var deliveriesList []map[string]interface{}
+	for _, delivery := range deliveries {
+		deliveryMap := map[string]interface{}{
+			"id":          delivery.Id,
+			"event":       delivery.Event,
+			"status_code": delivery.StatusCode,
+			"success":     delivery.Success,
+			"error":       delivery.Error,
+			"created_at":  delivery.CreatedAt,
+		}
+		deliveriesList = append(deliveriesList, deliveryMap)
+	}
+
+	if err := d.Set("list", deliveriesList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceWebhookDeliveries() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWebhookDeliveriesRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The name of the Doppler project where the webhook is located",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"webhook": {
+				Description: "The slug of the webhook",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"list": {
+				Description: "List of recent delivery attempts for the webhook",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the delivery attempt",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"event": {
+							Description: "The event type that triggered the delivery",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status_code": {
+							Description: "The HTTP status code returned by the webhook endpoint",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"success": {
+							Description: "Whether the delivery succeeded",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"error": {
+							Description: "The error encountered while delivering the webhook, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "When the delivery was attempted",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
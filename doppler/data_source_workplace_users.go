@@ -0,0 +1,102 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceWorkplaceUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	perPage := 1000
+
+	users := []WorkplaceUser{}
+	for page := 1; ; page++ {
+		pageUsers, err := client.ListWorkplaceUsers(ctx, PageOptions{Page: page, PerPage: perPage})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		users = append(users, pageUsers...)
+		if len(pageUsers) < perPage {
+			break
+		}
+	}
+
+	d.SetId("workplace_users")
+
+	// Convert users to a list of maps for Terraform
+	var usersList []map[string]interface{}
+	for _, user := range users {
+		userMap := map[string]interface{}{
+			"slug":               user.Slug,
+			"email":              user.User.Email,
+			"name":               user.User.Name,
+			"access":             user.Access,
+			"workplace_role":     user.WorkplaceRole.Identifier,
+			"two_factor_enabled": user.User.MFAEnabled,
+			"created_at":         user.CreatedAt,
+		}
+		usersList = append(usersList, userMap)
+	}
+
+	if err := d.Set("list", usersList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceWorkplaceUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWorkplaceUsersRead,
+		Schema: map[string]*schema.Schema{
+			"list": {
+				Description: "List of users in the workplace",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Description: "The slug of the workplace user",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "The email address of the user",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the user",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"access": {
+							Description: "The user's access level in the workplace (e.g. `owner`, `admin`, `collaborator`)",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"workplace_role": {
+							Description: "The identifier of the user's workplace role",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"two_factor_enabled": {
+							Description: "Whether the user has two-factor authentication enabled",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "When the user joined the workplace",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,153 @@
+package doppler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure = &ephemeralDynamicSecretLeaseResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &ephemeralDynamicSecretLeaseResource{}
+)
+
+type ephemeralDynamicSecretLeaseResource struct {
+	client APIClient
+}
+
+type ephemeralDynamicSecretLeaseModel struct {
+	Project       types.String `tfsdk:"project"`
+	Config        types.String `tfsdk:"config"`
+	DynamicSecret types.String `tfsdk:"dynamic_secret"`
+	TtlSec        types.Int64  `tfsdk:"ttl_sec"`
+	Slug          types.String `tfsdk:"slug"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+	Credentials   types.Map    `tfsdk:"credentials"`
+}
+
+// dynamicSecretLeasePrivate is kept in the ephemeral resource's private data to revoke the lease on close.
+type dynamicSecretLeasePrivate struct {
+	Project       string `json:"project"`
+	Config        string `json:"config"`
+	DynamicSecret string `json:"dynamic_secret"`
+	Slug          string `json:"slug"`
+}
+
+const dynamicSecretLeasePrivateKey = "lease"
+
+func ephemeralDynamicSecretLease() ephemeral.EphemeralResource {
+	return &ephemeralDynamicSecretLeaseResource{}
+}
+
+func (r *ephemeralDynamicSecretLeaseResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dynamic_secret_lease"
+}
+
+func (r *ephemeralDynamicSecretLeaseResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues a lease of a dynamic secret for the duration of a Terraform run, revoking it once the run no longer needs it.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The name of the Doppler project",
+				Required:    true,
+			},
+			"config": schema.StringAttribute{
+				Description: "The name of the Doppler config",
+				Required:    true,
+			},
+			"dynamic_secret": schema.StringAttribute{
+				Description: "The slug of the dynamic secret to lease",
+				Required:    true,
+			},
+			"ttl_sec": schema.Int64Attribute{
+				Description: "How long, in seconds, the lease is valid for. Defaults to the dynamic secret's `default_ttl_sec`",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"slug": schema.StringAttribute{
+				Description: "The slug of the lease",
+				Computed:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "The datetime that the lease expires",
+				Computed:    true,
+			},
+			"credentials": schema.MapAttribute{
+				Description: "The leased credentials",
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *ephemeralDynamicSecretLeaseResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(APIClient)
+}
+
+func (r *ephemeralDynamicSecretLeaseResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralDynamicSecretLeaseModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lease, err := r.client.IssueDynamicSecretLease(ctx, data.Config.ValueString(), data.Project.ValueString(), data.DynamicSecret.ValueString(), int(data.TtlSec.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to issue dynamic secret lease", err.Error())
+		return
+	}
+
+	private, err := json.Marshal(dynamicSecretLeasePrivate{
+		Project:       data.Project.ValueString(),
+		Config:        data.Config.ValueString(),
+		DynamicSecret: data.DynamicSecret.ValueString(),
+		Slug:          lease.Slug,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to serialize dynamic secret lease", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, dynamicSecretLeasePrivateKey, private)...)
+
+	credentials, diags := types.MapValueFrom(ctx, types.StringType, lease.Value)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Slug = types.StringValue(lease.Slug)
+	data.ExpiresAt = types.StringValue(lease.ExpiresAt)
+	data.Credentials = credentials
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *ephemeralDynamicSecretLeaseResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, dynamicSecretLeasePrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var lease dynamicSecretLeasePrivate
+	if err := json.Unmarshal(private, &lease); err != nil {
+		resp.Diagnostics.AddError("Unable to parse dynamic secret lease", err.Error())
+		return
+	}
+
+	// The lease may have already expired
+	if err := r.client.RevokeDynamicSecretLease(ctx, lease.Config, lease.Project, lease.DynamicSecret, lease.Slug); err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Unable to revoke dynamic secret lease", err.Error())
+	}
+}
@@ -0,0 +1,71 @@
+package doppler
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &verifyWebhookSignatureFunction{}
+
+// The prefix of the X-Doppler-Signature header, which is followed by the hex-encoded HMAC-SHA256 of the request body
+const webhookSignaturePrefix = "sha256="
+
+type verifyWebhookSignatureFunction struct{}
+
+func functionVerifyWebhookSignature() function.Function {
+	return &verifyWebhookSignatureFunction{}
+}
+
+func (f *verifyWebhookSignatureFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "verify_webhook_signature"
+}
+
+func (f *verifyWebhookSignatureFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Verify the signature of a Doppler webhook request",
+		Description: "Returns whether the `X-Doppler-Signature` header of a webhook request is the signature of its body, i.e. `sha256=` followed by the hex-encoded HMAC-SHA256 of the body keyed with the webhook's `secret`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "secret",
+				Description: "The `secret` of the webhook",
+			},
+			function.StringParameter{
+				Name:        "body",
+				Description: "The body of the webhook request",
+			},
+			function.StringParameter{
+				Name:        "header",
+				Description: "The value of the request's `X-Doppler-Signature` header",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *verifyWebhookSignatureFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var secret, body, header string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &secret, &body, &header))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, verifyWebhookSignature(secret, body, header)))
+}
+
+func verifyWebhookSignature(secret string, body string, header string) bool {
+	encodedSignature, ok := strings.CutPrefix(header, webhookSignaturePrefix)
+	if !ok {
+		return false
+	}
+	signature, err := hex.DecodeString(encodedSignature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hmac.Equal(signature, mac.Sum(nil))
+}
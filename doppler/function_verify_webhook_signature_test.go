@@ -0,0 +1,76 @@
+package doppler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	// echo -n '{"type":"secrets.update"}' | openssl dgst -sha256 -hmac secret
+	const signature = "sha256=882d7f9e610df620a1d3e6ac122942409e8a2744c1efc2ab3f63064305e19297"
+	body := `{"type":"secrets.update"}`
+
+	tests := []struct {
+		name   string
+		secret string
+		body   string
+		header string
+		want   bool
+	}{
+		{"valid", "secret", body, signature, true},
+		{"wrong secret", "other", body, signature, false},
+		{"modified body", "secret", body + " ", signature, false},
+		{"missing prefix", "secret", body, signature[len(webhookSignaturePrefix):], false},
+		{"not hex", "secret", body, "sha256=zz", false},
+		{"empty", "secret", body, "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := verifyWebhookSignature(test.secret, test.body, test.header); got != test.want {
+				t.Errorf("got %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestVerifyWebhookSignatureFunction(t *testing.T) {
+	providerServer, err := ProviderServer(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	functionServer, ok := providerServer().(tfprotov5.FunctionServer)
+	if !ok {
+		t.Fatal("the provider server doesn't serve functions")
+	}
+
+	arguments := []*tfprotov5.DynamicValue{}
+	for _, argument := range []string{"secret", `{"type":"secrets.update"}`, "sha256=882d7f9e610df620a1d3e6ac122942409e8a2744c1efc2ab3f63064305e19297"} {
+		value, err := tfprotov5.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, argument))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		arguments = append(arguments, &value)
+	}
+
+	resp, err := functionServer.CallFunction(context.Background(), &tfprotov5.CallFunctionRequest{
+		Name:      "verify_webhook_signature",
+		Arguments: arguments,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.Error != nil {
+		t.Fatalf("unexpected function error: %s", resp.Error.Text)
+	}
+	result, err := resp.Result.Unmarshal(tftypes.Bool)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var verified bool
+	if err = result.As(&verified); err != nil || !verified {
+		t.Errorf("got %t, want true", verified)
+	}
+}
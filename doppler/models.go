@@ -144,15 +144,20 @@ func parseIntegrationMemberId(id string) (integration string, memberType string,
 type IntegrationData = map[string]interface{}
 
 type Integration struct {
-	Slug string `json:"slug"`
-	Name string `json:"name"`
-	Type string `json:"type"`
+	Slug string          `json:"slug"`
+	Name string          `json:"name"`
+	Type string          `json:"type"`
+	Data IntegrationData `json:"data,omitempty"`
 }
 
 type IntegrationResponse struct {
 	Integration Integration `json:"integration"`
 }
 
+type IntegrationsResponse struct {
+	Integrations []Integration `json:"integrations"`
+}
+
 type SyncData = map[string]interface{}
 
 type Sync struct {
@@ -168,20 +173,58 @@ type SyncResponse struct {
 
 type RotatedSecretParameters = map[string]interface{}
 type RotatedSecretCredentials = []map[string]interface{}
+type RotatedSecretNames = map[string]string
 
 type RotatedSecret struct {
-	Slug              string      `json:"slug"`
-	Project           string      `json:"project"`
-	Config            string      `json:"config"`
-	Integration       Integration `json:"integration"`
-	RotationPeriodSec int         `json:"rotation_period_sec"`
-	Name              string      `json:"name"`
+	Slug              string             `json:"slug"`
+	Project           string             `json:"project"`
+	Config            string             `json:"config"`
+	Integration       Integration        `json:"integration"`
+	RotationPeriodSec int                `json:"rotation_period_sec"`
+	Name              string             `json:"name"`
+	LastRotatedAt     string             `json:"last_rotated_at"`
+	NextRotationAt    string             `json:"next_rotation_at"`
+	SecretNames       RotatedSecretNames `json:"secret_names"`
+	Status            string             `json:"status"`
+	LastError         string             `json:"last_error"`
+	CreatedAt         string             `json:"created_at"`
 }
 
 type RotatedSecretResponse struct {
 	RotatedSecret RotatedSecret `json:"rotatedSecret"`
 }
 
+type RotatedSecretsResponse struct {
+	RotatedSecrets []RotatedSecret `json:"rotatedSecrets"`
+}
+
+type DynamicSecretParameters = map[string]interface{}
+
+type DynamicSecret struct {
+	Slug          string                  `json:"slug"`
+	Project       string                  `json:"project"`
+	Config        string                  `json:"config"`
+	Integration   Integration             `json:"integration"`
+	Name          string                  `json:"name"`
+	DefaultTtlSec int                     `json:"default_ttl_sec"`
+	MaxTtlSec     int                     `json:"max_ttl_sec"`
+	Parameters    DynamicSecretParameters `json:"parameters"`
+}
+
+type DynamicSecretResponse struct {
+	DynamicSecret DynamicSecret `json:"dynamicSecret"`
+}
+
+type DynamicSecretLease struct {
+	Slug      string            `json:"slug"`
+	ExpiresAt string            `json:"expires_at"`
+	Value     map[string]string `json:"value"`
+}
+
+type DynamicSecretLeaseResponse struct {
+	Lease DynamicSecretLease `json:"lease"`
+}
+
 type ExternalIdResponse struct {
 	ExternalId string `json:"pendingExternalId"`
 }
@@ -222,17 +265,35 @@ type WebhookAuth struct {
 }
 
 type Webhook struct {
-	Slug           string   `json:"id"`
-	Name           string   `json:"name"`
-	Url            string   `json:"url"`
-	Enabled        bool     `json:"enabled"`
-	EnabledConfigs []string `json:"enabledConfigs"`
+	Slug            string       `json:"id"`
+	Name            string       `json:"name"`
+	Url             string       `json:"url"`
+	Enabled         bool         `json:"enabled"`
+	EnabledConfigs  []string     `json:"enabledConfigs"`
+	DisabledConfigs []string     `json:"disabledConfigs"`
+	Events          []string     `json:"events"`
+	Payload         string       `json:"payload"`
+	HasSecret       bool         `json:"hasSecret"`
+	Authentication  *WebhookAuth `json:"authentication"`
 }
 
 type WebhookResponse struct {
 	Webhook Webhook `json:"webhook"`
 }
 
+type WebhookDelivery struct {
+	Id         string `json:"id"`
+	Event      string `json:"event"`
+	StatusCode int    `json:"statusCode"`
+	Success    bool   `json:"success"`
+	Error      string `json:"error"`
+	CreatedAt  string `json:"createdAt"`
+}
+
+type WebhookDeliveriesResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
 type Config struct {
 	Slug        string             `json:"slug"`
 	Name        string             `json:"name"`
@@ -275,6 +336,7 @@ type ServiceToken struct {
 	Access      string `json:"access"`
 	Key         string `json:"key"`
 	CreatedAt   string `json:"created_at"`
+	ExpiresAt   string `json:"expires_at"`
 }
 
 type ServiceTokenResponse struct {
@@ -319,6 +381,24 @@ type ServiceAccountIdentityConfigOidc struct {
 	Claims       map[string][]string `json:"claims"`
 }
 
+type ServiceAccountIdentityConfigAwsIam struct {
+	AwsAccountId         string   `json:"aws_account_id"`
+	AllowedPrincipalArns []string `json:"allowed_principal_arns"`
+}
+
+type ServiceAccountIdentityConfigGcp struct {
+	AllowedServiceAccountEmails []string `json:"allowed_service_account_emails"`
+	AllowedProjectIds           []string `json:"allowed_project_ids,omitempty"`
+}
+
+type ServiceAccountIdentityConfigKubernetes struct {
+	KubernetesHost         string   `json:"kubernetes_host"`
+	CaCert                 string   `json:"ca_cert,omitempty"`
+	Audience               string   `json:"audience,omitempty"`
+	AllowedNamespaces      []string `json:"allowed_namespaces"`
+	AllowedServiceAccounts []string `json:"allowed_service_accounts"`
+}
+
 type ServiceAccountIdentity struct {
 	Slug       string          `json:"slug"`
 	Name       string          `json:"name"`
@@ -326,6 +406,10 @@ type ServiceAccountIdentity struct {
 	Method     string          `json:"method"`
 	Config     json.RawMessage `json:"config"`
 	ConfigOidc ServiceAccountIdentityConfigOidc
+	// Only populated for their corresponding `Method`
+	ConfigAwsIam     ServiceAccountIdentityConfigAwsIam
+	ConfigGcp        ServiceAccountIdentityConfigGcp
+	ConfigKubernetes ServiceAccountIdentityConfigKubernetes
 }
 
 type ServiceAccountIdentityResponse struct {
@@ -336,16 +420,21 @@ func (response *ServiceAccountIdentityResponse) unmarshal(data []byte) error {
 	if err := json.Unmarshal(data, response); err != nil {
 		return err
 	}
+	var config interface{}
 	switch response.Identity.Method {
 	case "oidc":
-		response.Identity.ConfigOidc = ServiceAccountIdentityConfigOidc{}
-		if err := json.Unmarshal(response.Identity.Config, &response.Identity.ConfigOidc); err != nil {
-			return err
-		}
+		config = &response.Identity.ConfigOidc
+	case "aws_iam":
+		config = &response.Identity.ConfigAwsIam
+	case "gcp":
+		config = &response.Identity.ConfigGcp
+	case "kubernetes":
+		config = &response.Identity.ConfigKubernetes
 	default:
-		return errors.New("Unknown auth method type")
+		// Unknown auth methods are preserved as their raw `Config`
+		return nil
 	}
-	return nil
+	return json.Unmarshal(response.Identity.Config, config)
 }
 
 func (id *ServiceAccountIdentity) marshal() ([]byte, error) {
@@ -361,8 +450,17 @@ func (id *ServiceAccountIdentity) marshal() ([]byte, error) {
 			"claims_type":   id.ConfigOidc.ClaimsType,
 			"claims":        id.ConfigOidc.Claims,
 		}
+	case "aws_iam":
+		payload["config"] = id.ConfigAwsIam
+	case "gcp":
+		payload["config"] = id.ConfigGcp
+	case "kubernetes":
+		payload["config"] = id.ConfigKubernetes
 	default:
-		return nil, errors.New("Unknown auth method type")
+		if id.Method == "" || len(id.Config) == 0 {
+			return nil, errors.New("Unknown auth method type")
+		}
+		payload["config"] = id.Config
 	}
 	return json.Marshal(payload)
 }
@@ -387,6 +485,10 @@ type ServiceAccountResponse struct {
 	ServiceAccount ServiceAccount `json:"service_account"`
 }
 
+type ServiceAccountsResponse struct {
+	ServiceAccounts []ServiceAccount `json:"service_accounts"`
+}
+
 type SimpleProjectRole struct {
 	Identifier string `json:"identifier"`
 }
@@ -444,8 +546,22 @@ type GetGroupMembersResponse struct {
 	Members []GroupMember `json:"members"`
 }
 
+type WorkplaceUserDetails struct {
+	Email      string `json:"email"`
+	Name       string `json:"name"`
+	MFAEnabled bool   `json:"mfa_enabled"`
+}
+
 type WorkplaceUser struct {
-	Slug string `json:"id"`
+	Slug          string               `json:"id"`
+	Access        string               `json:"access"`
+	WorkplaceRole SimpleWorkplaceRole  `json:"workplace_role"`
+	CreatedAt     string               `json:"created_at"`
+	User          WorkplaceUserDetails `json:"user"`
+}
+
+type WorkplaceUserResponse struct {
+	WorkplaceUser WorkplaceUser `json:"workplace_user"`
 }
 
 type WorkplaceUsersListResponse struct {
@@ -499,6 +615,9 @@ type ChangeRequestPolicyResponse struct {
 	Policy ChangeRequestPolicy `json:"policy"`
 }
 
+// The event types a webhook can be filtered to
+var WebhookEvents = []string{"secrets.update", "config.create", "config.update", "config.delete", "sync.failed"}
+
 var NameTransformers = []string{"none", "camel", "upper-camel", "lower-snake", "tf-var", "dotnet", "dotnet-env", "lower-kebab"}
 
 type GetWorkplaceRoleResponse struct {
@@ -528,3 +647,83 @@ func parseTrustedIPsResourceId(id string) (project string, config string, err er
 	}
 	return tokens[0], tokens[1], nil
 }
+
+type AuditLogStream struct {
+	Slug    string `json:"slug"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Url     string `json:"url"`
+	Enabled bool   `json:"enabled"`
+}
+
+type AuditLogStreamResponse struct {
+	Stream AuditLogStream `json:"stream"`
+}
+
+type AuditLogUser struct {
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+type AuditLog struct {
+	Id          string       `json:"id"`
+	Text        string       `json:"text"`
+	User        AuditLogUser `json:"user"`
+	Project     string       `json:"enclave_project"`
+	Environment string       `json:"enclave_environment"`
+	Config      string       `json:"enclave_config"`
+	CreatedAt   string       `json:"created_at"`
+}
+
+type AuditLogsResponse struct {
+	Logs []AuditLog `json:"logs"`
+}
+
+type WorkplaceSettings struct {
+	Slug                    string `json:"id"`
+	Name                    string `json:"name"`
+	BillingEmail            string `json:"billing_email"`
+	SecurityEmail           string `json:"security_email"`
+	EnforceSSO              bool   `json:"enforce_sso"`
+	Require2FA              bool   `json:"require_2fa"`
+	DefaultSecretVisibility string `json:"default_secret_visibility"`
+}
+
+type WorkplaceSettingsResponse struct {
+	Workplace WorkplaceSettings `json:"workplace"`
+}
+
+type WorkplaceSAML struct {
+	Enabled     bool   `json:"enabled"`
+	MetadataXML string `json:"metadata_xml"`
+	MetadataURL string `json:"metadata_url"`
+	SCIMEnabled bool   `json:"scim_enabled"`
+	SPEntityId  string `json:"sp_entity_id"`
+	SPACSUrl    string `json:"sp_acs_url"`
+	SCIMBaseUrl string `json:"scim_base_url"`
+}
+
+type WorkplaceSAMLResponse struct {
+	SAML WorkplaceSAML `json:"saml"`
+}
+
+type TokenInfoWorkplace struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+type TokenInfo struct {
+	Slug         string             `json:"slug"`
+	Name         string             `json:"name"`
+	Type         string             `json:"type"`
+	Scope        string             `json:"scope"`
+	TokenPreview string             `json:"token_preview"`
+	CreatedAt    string             `json:"created_at"`
+	LastSeenAt   string             `json:"last_seen_at"`
+	Workplace    TokenInfoWorkplace `json:"workplace"`
+}
+
+// Service tokens are scoped to a single config, all other token types act on the whole workplace
+func (t TokenInfo) isWorkplaceToken() bool {
+	return t.Type != "service_token"
+}
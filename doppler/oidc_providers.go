@@ -0,0 +1,116 @@
+package doppler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+const (
+	oidcProviderGitHubActions = "github_actions"
+	oidcProviderGitLab        = "gitlab"
+	oidcProviderKubernetes    = "kubernetes"
+	oidcProviderAuto          = "auto"
+)
+
+var oidcProviders = []string{oidcProviderGitHubActions, oidcProviderGitLab, oidcProviderKubernetes, oidcProviderAuto}
+
+// The client used to request ID tokens from OIDC providers. Unlike the Doppler API client it never presents
+// the configured client certificate or skips TLS verification, since the provider is a third party.
+var oidcProviderHTTPClient = &http.Client{}
+
+// The token mounted into every pod that uses a Kubernetes service account
+const kubernetesServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+// The variables GitLab CI exposes ID tokens through, in order of preference.
+// `GITLAB_OIDC_TOKEN` is the conventional name for an `id_tokens` entry, the others are the legacy predefined variables.
+var gitLabOIDCTokenEnvVars = []string{"GITLAB_OIDC_TOKEN", "CI_JOB_JWT_V2", "CI_JOB_JWT"}
+
+// detectOIDCProvider resolves the `auto` OIDC provider to the CI platform or runtime that Terraform is running in.
+func detectOIDCProvider() (string, error) {
+	if os.Getenv("GITHUB_ACTIONS") == "true" {
+		return oidcProviderGitHubActions, nil
+	}
+	if os.Getenv("GITLAB_CI") == "true" {
+		return oidcProviderGitLab, nil
+	}
+	if os.Getenv("KUBERNETES_SERVICE_HOST") != "" {
+		if _, err := os.Stat(kubernetesServiceAccountTokenPath); err == nil {
+			return oidcProviderKubernetes, nil
+		}
+	}
+	return "", fmt.Errorf("unable to detect an OIDC provider, expected to run in GitHub Actions, GitLab CI or a Kubernetes pod")
+}
+
+// getGitLabOIDCToken reads the GitLab CI ID token from the environment.
+func getGitLabOIDCToken() (string, error) {
+	for _, envVar := range gitLabOIDCTokenEnvVars {
+		if token := strings.TrimSpace(os.Getenv(envVar)); token != "" {
+			return token, nil
+		}
+	}
+	return "", fmt.Errorf("no GitLab ID token found, define an `id_tokens` entry named one of %s", strings.Join(gitLabOIDCTokenEnvVars, ", "))
+}
+
+type gitHubActionsIDTokenResponse struct {
+	Value string `json:"value"`
+}
+
+// fetchGitHubActionsOIDCToken requests an ID token from the GitHub Actions OIDC provider.
+// The workflow must grant the `id-token: write` permission for the request variables to be set.
+func fetchGitHubActionsOIDCToken(ctx context.Context, httpClient *http.Client, audience string) (string, error) {
+	requestUrl := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL")
+	requestToken := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN")
+	if requestUrl == "" || requestToken == "" {
+		return "", fmt.Errorf("ACTIONS_ID_TOKEN_REQUEST_URL and ACTIONS_ID_TOKEN_REQUEST_TOKEN are not set, ensure the workflow has the `id-token: write` permission")
+	}
+
+	parsedUrl, err := url.Parse(requestUrl)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse ACTIONS_ID_TOKEN_REQUEST_URL: %w", err)
+	}
+	if audience != "" {
+		query := parsedUrl.Query()
+		query.Set("audience", audience)
+		parsedUrl.RawQuery = query.Encode()
+	}
+
+	requestCtx, cancel := requestContext(ctx)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(requestCtx, "GET", parsedUrl.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("unable to create GitHub Actions ID token request: %w", err)
+	}
+	req.Header.Set("user-agent", fmt.Sprintf("terraform-provider-doppler/%s", ProviderVersion))
+	req.Header.Set("accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", requestToken))
+
+	r, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("GitHub Actions ID token request failed: %w", err)
+	}
+	defer r.Body.Close()
+
+	responseBody, err := io.ReadAll(io.LimitReader(r.Body, maxOIDCResponseBytes))
+	if err != nil {
+		return "", fmt.Errorf("unable to read GitHub Actions ID token response: %w", err)
+	}
+	if !isSuccess(r.StatusCode) {
+		return "", fmt.Errorf("GitHub Actions ID token request failed with HTTP %d", r.StatusCode)
+	}
+
+	var result gitHubActionsIDTokenResponse
+	if err := json.Unmarshal(responseBody, &result); err != nil {
+		return "", fmt.Errorf("unable to parse GitHub Actions ID token response: %w", err)
+	}
+	if result.Value == "" {
+		return "", fmt.Errorf("GitHub Actions ID token response did not contain a token")
+	}
+	return result.Value, nil
+}
@@ -0,0 +1,82 @@
+package doppler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFetchGitHubActionsOIDCToken(t *testing.T) {
+	tests := []struct {
+		name         string
+		audience     string
+		status       int
+		body         string
+		wantToken    string
+		wantErr      string
+		wantAudience string
+	}{
+		{name: "audience", audience: "https://api.doppler.com", status: http.StatusOK, body: `{"value":"jwt"}`, wantToken: "jwt", wantAudience: "https://api.doppler.com"},
+		{name: "default audience", status: http.StatusOK, body: `{"value":"jwt"}`, wantToken: "jwt"},
+		{name: "non-2xx", status: http.StatusForbidden, body: `{"message":"forbidden"}`, wantErr: "HTTP 403"},
+		{name: "empty value", status: http.StatusOK, body: `{"value":""}`, wantErr: "did not contain a token"},
+		{name: "invalid json", status: http.StatusOK, body: `not json`, wantErr: "unable to parse"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if got := r.Header.Get("Authorization"); got != "Bearer request-token" {
+					t.Errorf("Authorization header: got %q, want %q", got, "Bearer request-token")
+				}
+				query := r.URL.Query()
+				if got := query.Get("audience"); got != test.wantAudience {
+					t.Errorf("audience: got %q, want %q", got, test.wantAudience)
+				}
+				if _, ok := query["audience"]; ok != (test.wantAudience != "") {
+					t.Errorf("audience param present: got %t, want %t", ok, test.wantAudience != "")
+				}
+				// Query params already in the request URL are preserved
+				if got := query.Get("api-version"); got != "2.0" {
+					t.Errorf("api-version: got %q, want %q", got, "2.0")
+				}
+				w.WriteHeader(test.status)
+				w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", server.URL+"/token?api-version=2.0")
+			t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "request-token")
+
+			token, err := fetchGitHubActionsOIDCToken(context.Background(), server.Client(), test.audience)
+			if requests != 1 {
+				t.Errorf("got %d requests, want 1", requests)
+			}
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("got error %v, want it to contain %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if token != test.wantToken {
+				t.Errorf("got token %q, want %q", token, test.wantToken)
+			}
+		})
+	}
+}
+
+func TestFetchGitHubActionsOIDCTokenWithoutPermission(t *testing.T) {
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", "")
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "")
+
+	_, err := fetchGitHubActionsOIDCToken(context.Background(), http.DefaultClient, "")
+	if err == nil || !strings.Contains(err.Error(), "id-token: write") {
+		t.Fatalf("got error %v, want a missing permission error", err)
+	}
+}
@@ -3,8 +3,10 @@ package doppler
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -15,7 +17,7 @@ import (
 const defaultAPIHost = "https://api.doppler.com"
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"host": {
 				Description: "The Doppler API host (i.e. https://api.doppler.com). This can also be set via the DOPPLER_API_HOST environment variable.",
@@ -29,12 +31,73 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_VERIFY_TLS", true),
 			},
+			"ca_cert_file": {
+				Description: "A path to a file containing PEM-encoded CA certificates to trust in addition to the system roots (e.g. for a TLS-inspecting proxy). Only one of `ca_cert_file` or `ca_cert_pem` may be set. This can also be set via the DOPPLER_CA_CERT_FILE environment variable.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_CA_CERT_FILE", nil),
+			},
+			"ca_cert_pem": {
+				Description: "PEM-encoded CA certificates to trust in addition to the system roots (e.g. for a TLS-inspecting proxy). Only one of `ca_cert_file` or `ca_cert_pem` may be set. This can also be set via the DOPPLER_CA_CERT_PEM environment variable.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_CA_CERT_PEM", nil),
+			},
+			"client_cert": {
+				Description: "A PEM-encoded client certificate to present for mutual TLS. Requires `client_key`. This can also be set via the DOPPLER_CLIENT_CERT environment variable.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_CLIENT_CERT", nil),
+			},
+			"client_key": {
+				Description: "The PEM-encoded private key of `client_cert`. This can also be set via the DOPPLER_CLIENT_KEY environment variable.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_CLIENT_KEY", nil),
+			},
+			"proxy_url": {
+				Description:  "The URL of the HTTP proxy to send API requests through. Defaults to the HTTPS_PROXY environment variable. Hosts listed in the NO_PROXY environment variable are always requested directly.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			},
 			"doppler_token": {
-				Description: "A Doppler token, either a personal or service token. This can also be set via the DOPPLER_TOKEN environment variable. Only one of `doppler_token` or OIDC authentication (`oidc_identity` + `oidc_token`/`oidc_token_file`) may be specified.",
+				Description: "A Doppler token, either a personal or service token. This can also be set via the DOPPLER_TOKEN environment variable. Only one of `doppler_token` or OIDC authentication (`oidc_identity` + `oidc_token`/`oidc_token_file`/`oidc_provider`) may be specified.",
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_TOKEN", nil),
 			},
+			"validate_token": {
+				Description: "Whether to look up the Doppler token when configuring the provider, failing early if it is invalid. The token's type is then used to reject resources and data sources that need a workplace-level token (i.e. not a service token) at plan time. This can also be set via the DOPPLER_VALIDATE_TOKEN environment variable.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_VALIDATE_TOKEN", false),
+			},
+			"default_project": {
+				Description: "The project used by resources that omit their `project` (`doppler_secret`, `doppler_service_token`, `doppler_trusted_ips` and the secrets syncs). This can also be set via the DOPPLER_PROJECT environment variable.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_PROJECT", nil),
+			},
+			"default_config": {
+				Description: "The config used by resources that omit their `config` (`doppler_secret`, `doppler_service_token`, `doppler_trusted_ips` and the secrets syncs). This can also be set via the DOPPLER_CONFIG environment variable.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_CONFIG", nil),
+			},
+			"read_only": {
+				Description: "Whether to refuse any API request that would modify Doppler, e.g. for pipelines that only run `terraform plan`. This can also be set via the DOPPLER_READ_ONLY environment variable.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_READ_ONLY", false),
+			},
+			"use_cli_config": {
+				Description: "Whether to authenticate with the token that the Doppler CLI has saved for the working directory (e.g. by `doppler login`), when neither `doppler_token` nor OIDC authentication is specified. The CLI's scoped `api-host` and `verify-tls` settings are used unless `host` or `verify_tls` are set. The CLI configuration is read from `~/.doppler/.doppler.yaml`, or from DOPPLER_CONFIG_DIR if set. This can also be set via the DOPPLER_USE_CLI_CONFIG environment variable.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_USE_CLI_CONFIG", false),
+			},
 			"oidc_identity": {
 				Description:  "The identity ID (UUID) of the Doppler service account identity for OIDC authentication. This can also be set via the DOPPLER_OIDC_IDENTITY environment variable.",
 				Type:         schema.TypeString,
@@ -56,6 +119,19 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_OIDC_TOKEN_FILE", nil),
 			},
+			"oidc_provider": {
+				Description:  "Acquire the OIDC token automatically from the environment Terraform runs in when neither `oidc_token` nor `oidc_token_file` is set. One of `github_actions`, `gitlab`, `kubernetes` or `auto` (detect the environment). This can also be set via the DOPPLER_OIDC_PROVIDER environment variable.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DOPPLER_OIDC_PROVIDER", nil),
+				ValidateFunc: validation.StringInSlice(oidcProviders, false),
+			},
+			"oidc_audience": {
+				Description: "The audience to request the GitHub Actions OIDC token for. Defaults to GitHub's default audience (the URL of the repository owner). This can also be set via the DOPPLER_OIDC_AUDIENCE environment variable.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_OIDC_AUDIENCE", nil),
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"doppler_secret":        resourceSecret(),
@@ -68,18 +144,26 @@ func Provider() *schema.Provider {
 
 			"doppler_project_role": resourceProjectRole(),
 
-			"doppler_workplace_role": resourceWorkplaceRole(),
+			"doppler_workplace_role":     resourceWorkplaceRole(),
+			"doppler_workplace_settings": resourceWorkplaceSettings(),
+			"doppler_workplace_sso":      resourceWorkplaceSSO(),
+			"doppler_workplace_user":     resourceWorkplaceUser(),
 
-			"doppler_service_account":          resourceServiceAccount(),
-			"doppler_service_account_token":    resourceServiceAccountToken(),
-			"doppler_service_account_identity": resourceServiceAccountIdentity(),
+			"doppler_service_account":                resourceServiceAccount(),
+			"doppler_service_account_token":          resourceServiceAccountToken(),
+			"doppler_service_account_token_rotating": resourceServiceAccountTokenRotating(),
+			"doppler_service_account_identity":       resourceServiceAccountIdentity(),
 
 			"doppler_group":         resourceGroup(),
 			"doppler_group_member":  resourceGroupMemberWorkplaceUser(),
 			"doppler_group_members": resourceGroupMembers(),
 
+			"doppler_group_member_service_account": resourceGroupMemberServiceAccount(),
+
 			"doppler_webhook": resourceWebhook(),
 
+			"doppler_audit_log_stream": resourceAuditLogStream(),
+
 			"doppler_change_request_policy": resourceChangeRequestPolicy(),
 
 			"doppler_project_member_group":           resourceProjectMemberGroup(),
@@ -140,6 +224,9 @@ func Provider() *schema.Provider {
 			"doppler_rotated_secret_aws_postgres":             resourceRotatedSecretAWSPostgres(),
 			"doppler_rotated_secret_gcp_service_account_keys": resourceRotatedSecretGCPServiceAccountKeys(),
 
+			"doppler_dynamic_secret_aws_iam_user":        resourceDynamicSecretAWSIAMUser(),
+			"doppler_dynamic_secret_gcp_service_account": resourceDynamicSecretGCPServiceAccount(),
+
 			// creating Azure Vault oauth integrations is not currently supported
 			// "doppler_integration_azure_vault":  resourceIntegrationAzureVault(),
 			"doppler_integration_azure_vault_service_principal": resourceIntegrationAzureVaultServicePrincipal(),
@@ -153,9 +240,38 @@ func Provider() *schema.Provider {
 			"doppler_user":         dataSourceUser(),
 			"doppler_group":        dataSourceGroup(),
 			"doppler_environments": dataSourceEnvironments(),
+
+			"doppler_rotated_secrets": dataSourceRotatedSecrets(),
+			"doppler_integrations":    dataSourceIntegrations(),
+			"doppler_integration":     dataSourceIntegration(),
+
+			"doppler_webhook_deliveries": dataSourceWebhookDeliveries(),
+
+			"doppler_audit_logs": dataSourceAuditLogs(),
+
+			"doppler_workplace_users": dataSourceWorkplaceUsers(),
+			"doppler_groups":          dataSourceGroups(),
+
+			"doppler_service_account":  dataSourceServiceAccount(),
+			"doppler_service_accounts": dataSourceServiceAccounts(),
+
+			"doppler_token_info": dataSourceTokenInfo(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
+
+	for resourceType, resource := range provider.ResourcesMap {
+		if !configScopedResources[resourceType] {
+			resource.CustomizeDiff = requireWorkplaceToken(resourceType, resource.CustomizeDiff)
+		}
+	}
+	for dataSourceType, dataSource := range provider.DataSourcesMap {
+		if !configScopedDataSources[dataSourceType] {
+			dataSource.ReadContext = requireWorkplaceTokenRead(dataSourceType, dataSource.ReadContext)
+		}
+	}
+
+	return provider
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -166,18 +282,61 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	oidcIdentity := d.Get("oidc_identity").(string)
 	oidcToken := d.Get("oidc_token").(string)
 	oidcTokenFile := d.Get("oidc_token_file").(string)
+	oidcProvider := d.Get("oidc_provider").(string)
+	oidcAudience := d.Get("oidc_audience").(string)
 
 	var diags diag.Diagnostics
 
+	hasOIDC := oidcIdentity != "" || oidcToken != "" || oidcTokenFile != "" || oidcProvider != ""
+	if d.Get("use_cli_config").(bool) && token == "" && !hasOIDC {
+		cliOptions, err := cliConfigOptions()
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read Doppler CLI configuration",
+				Detail:   fmt.Sprintf("`use_cli_config` is enabled but the Doppler CLI configuration could not be read: %s", err),
+			})
+			return nil, diags
+		}
+		token = cliOptions.Token
+		if cliOptions.APIHost != "" && !isProviderSettingSet(d, "host", "DOPPLER_API_HOST") {
+			host = cliOptions.APIHost
+		}
+		if cliOptions.VerifyTLS != "" && !isProviderSettingSet(d, "verify_tls", "DOPPLER_VERIFY_TLS") {
+			cliVerifyTLS, err := strconv.ParseBool(cliOptions.VerifyTLS)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Invalid Doppler CLI configuration",
+					Detail:   fmt.Sprintf("The Doppler CLI's `verify-tls` setting %q is not a boolean.", cliOptions.VerifyTLS),
+				})
+				return nil, diags
+			}
+			verifyTLS = cliVerifyTLS
+		}
+	}
+
+	httpClient, httpClientDiags := providerHTTPClient(d, verifyTLS)
+	diags = append(diags, httpClientDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	client := APIClient{
+		Host:           host,
+		HTTPClient:     httpClient,
+		ReadOnly:       d.Get("read_only").(bool),
+		DefaultProject: d.Get("default_project").(string),
+		DefaultConfig:  d.Get("default_config").(string),
+	}
+
 	hasToken := token != ""
-	hasOIDC := oidcIdentity != "" || oidcToken != "" || oidcTokenFile != ""
 
 	if hasToken && hasOIDC {
 		return nil, diag.Diagnostics{
 			diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  "Conflicting authentication configuration",
-				Detail:   "Only one of `doppler_token` or OIDC authentication (`oidc_identity` + `oidc_token`/`oidc_token_file`) may be specified.",
+				Detail:   "Only one of `doppler_token` or OIDC authentication (`oidc_identity` + `oidc_token`/`oidc_token_file`/`oidc_provider`) may be specified.",
 			},
 		}
 	}
@@ -187,7 +346,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 			diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  "Missing authentication configuration",
-				Detail:   "Either `doppler_token` or OIDC authentication (`oidc_identity` + `oidc_token`/`oidc_token_file`) must be specified.",
+				Detail:   "Either `doppler_token` or OIDC authentication (`oidc_identity` + `oidc_token`/`oidc_token_file`/`oidc_provider`) must be specified.",
 			},
 		}
 	}
@@ -214,12 +373,31 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		}
 
 		if oidcToken == "" && oidcTokenFile == "" {
-			return nil, diag.Diagnostics{
-				diag.Diagnostic{
-					Severity: diag.Error,
-					Summary:  "Missing OIDC token",
-					Detail:   "One of `oidc_token` or `oidc_token_file` must be specified when using OIDC authentication.",
-				},
+			if oidcProvider == "" {
+				return nil, diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Missing OIDC token",
+						Detail:   "One of `oidc_token`, `oidc_token_file` or `oidc_provider` must be specified when using OIDC authentication.",
+					},
+				}
+			}
+
+			if oidcProvider == oidcProviderAuto {
+				detectedProvider, err := detectOIDCProvider()
+				if err != nil {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Unable to detect OIDC provider",
+						Detail:   fmt.Sprintf("`oidc_provider` is \"auto\" but %s. Set `oidc_provider` explicitly instead.", err),
+					})
+					return nil, diags
+				}
+				oidcProvider = detectedProvider
+			}
+
+			if oidcProvider == oidcProviderKubernetes {
+				oidcTokenFile = kubernetesServiceAccountTokenPath
 			}
 		}
 
@@ -239,45 +417,30 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 			})
 		}
 
-		jwt := oidcToken
+		getOIDCToken := oidcTokenSource(oidcToken, oidcTokenFile, oidcProvider, oidcAudience)
+
+		var jwt string
 		if oidcTokenFile != "" {
-			if !filepath.IsAbs(oidcTokenFile) {
-				diags = append(diags, diag.Diagnostic{
-					Severity: diag.Error,
-					Summary:  "Invalid OIDC token file path",
-					Detail:   "`oidc_token_file` must be an absolute path.",
-				})
+			var fileDiags diag.Diagnostics
+			jwt, fileDiags = readOIDCTokenFile(oidcTokenFile)
+			diags = append(diags, fileDiags...)
+			if fileDiags.HasError() {
 				return nil, diags
 			}
-			contents, err := os.ReadFile(oidcTokenFile)
+		} else {
+			var err error
+			jwt, err = getOIDCToken(ctx)
 			if err != nil {
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
-					Summary:  "Unable to read OIDC token file",
-					Detail:   fmt.Sprintf("Failed to read OIDC token from file %q. Verify the file exists and is readable by the Terraform process.", oidcTokenFile),
-				})
-				return nil, diags
-			}
-			jwt = strings.TrimSpace(string(contents))
-			if jwt == "" {
-				diags = append(diags, diag.Diagnostic{
-					Severity: diag.Error,
-					Summary:  "Empty OIDC token file",
-					Detail:   fmt.Sprintf("OIDC token file %q exists but is empty.", oidcTokenFile),
-				})
-				return nil, diags
-			}
-			if !isJWTShaped(jwt) {
-				diags = append(diags, diag.Diagnostic{
-					Severity: diag.Error,
-					Summary:  "Invalid OIDC token format",
-					Detail:   fmt.Sprintf("OIDC token file %q does not contain a well-formed JWT (expected three dot-separated parts).", oidcTokenFile),
+					Summary:  "Unable to acquire OIDC token",
+					Detail:   fmt.Sprintf("Failed to acquire an OIDC token from the %q OIDC provider: %s", oidcProvider, err),
 				})
 				return nil, diags
 			}
 		}
 
-		apiToken, err := exchangeOIDCToken(ctx, host, oidcIdentity, jwt, verifyTLS)
+		result, err := exchangeOIDCToken(ctx, httpClient, host, oidcIdentity, jwt)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -286,8 +449,190 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 			})
 			return nil, diags
 		}
-		token = apiToken
+
+		// The session exchanges a new OIDC token whenever the API token expires
+		session := &OIDCSession{
+			Host:         host,
+			Identity:     oidcIdentity,
+			HTTPClient:   httpClient,
+			GetOIDCToken: getOIDCToken,
+		}
+		session.update(result)
+		token = result.Token
+		client.OIDCSession = session
+	}
+	client.APIKey = token
+
+	if d.Get("validate_token").(bool) {
+		tokenInfo, err := client.GetTokenInfo(ctx)
+		if err != nil {
+			detail := fmt.Sprintf("Unable to look up the Doppler token: %s", err)
+			if isUnauthorizedError(err) || isForbiddenError(err) {
+				detail = fmt.Sprintf("The Doppler token was rejected, verify that it is valid and hasn't been revoked: %s", err)
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Doppler token validation failed",
+				Detail:   detail,
+			})
+			return nil, diags
+		}
+		client.TokenInfo = tokenInfo
+	}
+
+	return client, diags
+}
+
+// cliConfigOptions returns the Doppler CLI settings scoped to the working directory.
+func cliConfigOptions() (*cliScopedOptions, error) {
+	path, err := cliConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	config, err := loadCLIConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine the working directory: %w", err)
+	}
+	options := config.resolve(dir)
+	if options.Token == "" {
+		return nil, fmt.Errorf("no token is configured for %s, run `doppler login` first", dir)
+	}
+	return &options, nil
+}
+
+// isProviderSettingSet reports whether a setting was given explicitly, rather than falling back to its default.
+func isProviderSettingSet(d *schema.ResourceData, key string, envVar string) bool {
+	if _, ok := os.LookupEnv(envVar); ok {
+		return true
+	}
+	rawConfig := d.GetRawConfig()
+	return !rawConfig.IsNull() && !rawConfig.GetAttr(key).IsNull()
+}
+
+// providerHTTPClient builds the HTTP client shared by all API requests from the provider's TLS and proxy settings.
+func providerHTTPClient(d *schema.ResourceData, verifyTLS bool) (*http.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	caCertFile := d.Get("ca_cert_file").(string)
+	caCertPEM := d.Get("ca_cert_pem").(string)
+	clientCert := d.Get("client_cert").(string)
+	clientKey := d.Get("client_key").(string)
+
+	if caCertFile != "" && caCertPEM != "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Conflicting CA certificate configuration",
+			Detail:   "Only one of `ca_cert_file` or `ca_cert_pem` may be specified, not both.",
+		})
+		return nil, diags
+	}
+
+	if (clientCert == "") != (clientKey == "") {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Incomplete client certificate configuration",
+			Detail:   "`client_cert` and `client_key` must be specified together.",
+		})
+		return nil, diags
+	}
+
+	if caCertFile != "" {
+		contents, err := os.ReadFile(caCertFile)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read CA certificate file",
+				Detail:   fmt.Sprintf("Failed to read CA certificates from file %q. Verify the file exists and is readable by the Terraform process.", caCertFile),
+			})
+			return nil, diags
+		}
+		caCertPEM = string(contents)
+	}
+
+	httpClient, err := httpClientWithTLSConfig(HTTPClientConfig{
+		VerifyTLS:     verifyTLS,
+		CACertPEM:     caCertPEM,
+		ClientCertPEM: clientCert,
+		ClientKeyPEM:  clientKey,
+		ProxyURL:      d.Get("proxy_url").(string),
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid TLS configuration",
+			Detail:   fmt.Sprintf("Failed to configure the HTTP client: %s", err),
+		})
+		return nil, diags
+	}
+
+	return httpClient, diags
+}
+
+// oidcTokenSource returns a function that acquires a current OIDC token, re-reading `oidc_token_file` or
+// requesting a new token from the `oidc_provider` so that rotated tokens are picked up.
+func oidcTokenSource(oidcToken string, oidcTokenFile string, oidcProvider string, oidcAudience string) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		if oidcToken != "" {
+			return oidcToken, nil
+		}
+		if oidcTokenFile != "" {
+			jwt, diags := readOIDCTokenFile(oidcTokenFile)
+			for _, d := range diags {
+				if d.Severity == diag.Error {
+					return "", fmt.Errorf("%s: %s", d.Summary, d.Detail)
+				}
+			}
+			return jwt, nil
+		}
+		switch oidcProvider {
+		case oidcProviderGitHubActions:
+			return fetchGitHubActionsOIDCToken(ctx, oidcProviderHTTPClient, oidcAudience)
+		case oidcProviderGitLab:
+			return getGitLabOIDCToken()
+		}
+		return "", fmt.Errorf("unsupported OIDC provider %q", oidcProvider)
 	}
+}
 
-	return APIClient{Host: host, APIKey: token, VerifyTLS: verifyTLS}, diags
+func readOIDCTokenFile(oidcTokenFile string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !filepath.IsAbs(oidcTokenFile) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid OIDC token file path",
+			Detail:   "`oidc_token_file` must be an absolute path.",
+		})
+		return "", diags
+	}
+	contents, err := os.ReadFile(oidcTokenFile)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read OIDC token file",
+			Detail:   fmt.Sprintf("Failed to read OIDC token from file %q. Verify the file exists and is readable by the Terraform process.", oidcTokenFile),
+		})
+		return "", diags
+	}
+	jwt := strings.TrimSpace(string(contents))
+	if jwt == "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Empty OIDC token file",
+			Detail:   fmt.Sprintf("OIDC token file %q exists but is empty.", oidcTokenFile),
+		})
+		return "", diags
+	}
+	if !isJWTShaped(jwt) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid OIDC token format",
+			Detail:   fmt.Sprintf("OIDC token file %q does not contain a well-formed JWT (expected three dot-separated parts).", oidcTokenFile),
+		})
+		return "", diags
+	}
+	return jwt, diags
 }
@@ -0,0 +1,113 @@
+package doppler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProviderServer returns the provider's protocol server, which muxes the SDK provider (resources and data sources)
+// with a plugin framework provider serving the features the SDK doesn't support (ephemeral resources and functions).
+func ProviderServer(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	sdkProvider := Provider()
+	muxServer, err := tf5muxserver.NewMuxServer(
+		ctx,
+		// The SDK provider must come first, it's configured before the framework provider which reuses its client
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(&frameworkProvider{sdkProvider: sdkProvider}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
+// frameworkProvider shares the SDK provider's configuration rather than duplicating it: Terraform requires both
+// providers to declare the same schema, and the mux server configures the SDK provider first.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var (
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ provider.ProviderWithFunctions          = &frameworkProvider{}
+)
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "doppler"
+	resp.Version = ProviderVersion
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	attributes := map[string]providerschema.Attribute{}
+	for name, s := range p.sdkProvider.Schema {
+		switch s.Type {
+		case schema.TypeString:
+			attributes[name] = providerschema.StringAttribute{
+				Description: s.Description,
+				Required:    s.Required,
+				Optional:    s.Optional,
+				Sensitive:   s.Sensitive,
+			}
+		case schema.TypeBool:
+			attributes[name] = providerschema.BoolAttribute{
+				Description: s.Description,
+				Required:    s.Required,
+				Optional:    s.Optional,
+				Sensitive:   s.Sensitive,
+			}
+		case schema.TypeInt:
+			attributes[name] = providerschema.Int64Attribute{
+				Description: s.Description,
+				Required:    s.Required,
+				Optional:    s.Optional,
+				Sensitive:   s.Sensitive,
+			}
+		default:
+			resp.Diagnostics.AddError("Unsupported provider attribute", fmt.Sprintf("The provider attribute %q has a type that can't be declared by the framework provider", name))
+		}
+	}
+	resp.Schema = providerschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	client, ok := p.sdkProvider.Meta().(APIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Provider not configured", "The Doppler API client wasn't configured before the provider's ephemeral resources")
+		return
+	}
+	resp.EphemeralResourceData = client
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		ephemeralDynamicSecretLease,
+	}
+}
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functionVerifyWebhookSignature,
+	}
+}
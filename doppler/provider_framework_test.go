@@ -0,0 +1,149 @@
+package doppler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProviderServerSchema(t *testing.T) {
+	providerServer, err := ProviderServer(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The mux server rejects SDK and framework provider schemas that differ
+	resp, err := providerServer().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if _, ok := resp.ResourceSchemas["doppler_secret"]; !ok {
+		t.Error("missing resource doppler_secret")
+	}
+	if _, ok := resp.EphemeralResourceSchemas["doppler_dynamic_secret_lease"]; !ok {
+		t.Error("missing ephemeral resource doppler_dynamic_secret_lease")
+	}
+}
+
+func TestEphemeralDynamicSecretLease(t *testing.T) {
+	var revoked string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/v3/configs/config/dynamic_secrets/dynamic_secret/leases":
+			if got := r.URL.Query().Get("dynamic_secret"); got != "aws" {
+				t.Errorf("got dynamic_secret %q, want aws", got)
+			}
+			w.Write([]byte(`{"lease":{"slug":"lease-1","expires_at":"2030-01-01T00:00:00.000Z","value":{"ACCESS_KEY_ID":"AKIA","SECRET_ACCESS_KEY":"secret"}}}`))
+		case r.Method == "DELETE" && r.URL.Path == "/v3/configs/config/dynamic_secrets/dynamic_secret/leases/lease":
+			revoked = r.URL.Query().Get("slug")
+			w.Write([]byte(`{"success":true}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	providerServerFactory, err := ProviderServer(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	providerServer := providerServerFactory()
+	schemaResp, err := providerServer.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	providerConfig := map[string]tftypes.Value{}
+	providerConfigType := schemaResp.Provider.ValueType().(tftypes.Object)
+	for name, attributeType := range providerConfigType.AttributeTypes {
+		providerConfig[name] = tftypes.NewValue(attributeType, nil)
+	}
+	providerConfig["host"] = tftypes.NewValue(tftypes.String, server.URL)
+	providerConfig["doppler_token"] = tftypes.NewValue(tftypes.String, "dp.pt.test")
+	configureResp, err := providerServer.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{
+		Config: dynamicValue(t, providerConfigType, providerConfig),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, d := range configureResp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	// Ephemeral resources are served through an extension of the protocol server interface
+	ephemeralServer, ok := providerServer.(tfprotov5.ProviderServerWithEphemeralResources)
+	if !ok {
+		t.Fatal("the provider server doesn't serve ephemeral resources")
+	}
+
+	leaseType := schemaResp.EphemeralResourceSchemas["doppler_dynamic_secret_lease"].ValueType().(tftypes.Object)
+	openResp, err := ephemeralServer.OpenEphemeralResource(context.Background(), &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "doppler_dynamic_secret_lease",
+		Config: dynamicValue(t, leaseType, map[string]tftypes.Value{
+			"project":        tftypes.NewValue(tftypes.String, "backend"),
+			"config":         tftypes.NewValue(tftypes.String, "prd"),
+			"dynamic_secret": tftypes.NewValue(tftypes.String, "aws"),
+			"ttl_sec":        tftypes.NewValue(tftypes.Number, nil),
+			"slug":           tftypes.NewValue(tftypes.String, nil),
+			"expires_at":     tftypes.NewValue(tftypes.String, nil),
+			"credentials":    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, d := range openResp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	result, err := openResp.Result.Unmarshal(leaseType)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var resultAttributes map[string]tftypes.Value
+	if err = result.As(&resultAttributes); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var credentials map[string]tftypes.Value
+	if err = resultAttributes["credentials"].As(&credentials); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var accessKeyID string
+	if err = credentials["ACCESS_KEY_ID"].As(&accessKeyID); err != nil || accessKeyID != "AKIA" {
+		t.Errorf("got ACCESS_KEY_ID %q, want AKIA", accessKeyID)
+	}
+
+	closeResp, err := ephemeralServer.CloseEphemeralResource(context.Background(), &tfprotov5.CloseEphemeralResourceRequest{
+		TypeName: "doppler_dynamic_secret_lease",
+		Private:  openResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, d := range closeResp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if revoked != "lease-1" {
+		t.Errorf("got revoked lease %q, want lease-1", revoked)
+	}
+}
+
+func dynamicValue(t *testing.T, objectType tftypes.Object, attributes map[string]tftypes.Value) *tfprotov5.DynamicValue {
+	t.Helper()
+	value, err := tfprotov5.NewDynamicValue(objectType, tftypes.NewValue(objectType, attributes))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return &value
+}
@@ -1,9 +1,14 @@
 package doppler
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -16,18 +21,118 @@ func (e *CustomNotFoundError) Error() string {
 	return fmt.Sprintf("Doppler Error: %s", e.Message)
 }
 
-func handleNotFoundError(err error, d *schema.ResourceData) diag.Diagnostics {
-	isNotFoundError := false
+func isNotFoundError(err error) bool {
+	var notFoundError *NotFoundError
+	if errors.As(err, &notFoundError) {
+		return true
+	}
+
+	var customNotFoundError *CustomNotFoundError
+	return errors.As(err, &customNotFoundError)
+}
+
+func isUnauthorizedError(err error) bool {
+	var unauthorizedError *UnauthorizedError
+	return errors.As(err, &unauthorizedError)
+}
+
+func isForbiddenError(err error) bool {
+	var forbiddenError *ForbiddenError
+	return errors.As(err, &forbiddenError)
+}
+
+func isConflictError(err error) bool {
+	var conflictError *ConflictError
+	return errors.As(err, &conflictError)
+}
+
+func isValidationError(err error) bool {
+	var validationError *ValidationError
+	return errors.As(err, &validationError)
+}
+
+func isRateLimitedError(err error) bool {
+	var rateLimitedError *RateLimitedError
+	return errors.As(err, &rateLimitedError)
+}
+
+func isServerError(err error) bool {
+	var serverError *ServerError
+	return errors.As(err, &serverError)
+}
+
+// apiFieldAttributes maps the parameters that the API reports validation errors for to the attributes they're set from,
+// for parameters whose name differs from their attribute. List indexes are written as "*" and carried over in order,
+// e.g. "credentials.*.PASSWORD" maps to "credentials.*.password".
+type apiFieldAttributes map[string]string
 
-	if apiError, ok := err.(*APIError); ok && apiError.Response != nil && apiError.Response.HTTPResponse.StatusCode == 404 {
-		isNotFoundError = true
+// apiErrorDiagnostics converts an error into diagnostics, attributing any field-level validation messages
+// from the API to the attribute they concern. Messages for parameters that don't map to an attribute aren't attributed.
+func apiErrorDiagnostics(err error, d *schema.ResourceData, fieldAttributes apiFieldAttributes) diag.Diagnostics {
+	var validationError *ValidationError
+	if !errors.As(err, &validationError) || len(validationError.FieldErrors) == 0 {
+		return diag.FromErr(err)
 	}
 
-	if _, ok := err.(*CustomNotFoundError); ok {
-		isNotFoundError = true
+	configType := cty.DynamicPseudoType
+	if d != nil {
+		configType = d.GetRawConfig().Type()
 	}
 
-	if isNotFoundError {
+	var diags diag.Diagnostics
+	for _, fieldError := range validationError.FieldErrors {
+		diagnostic := diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  err.Error(),
+			Detail:   fmt.Sprintf("%s: %s", fieldError.Field, fieldError.Message),
+		}
+		if path, ok := fieldAttributePath(fieldError.Field, fieldAttributes, configType); ok {
+			diagnostic.Detail = fieldError.Message
+			diagnostic.AttributePath = path
+		}
+		diags = append(diags, diagnostic)
+	}
+	return diags
+}
+
+// fieldAttributePath converts an API parameter path such as "credentials.0.PASSWORD" into the path of the attribute it's set from.
+// Parameters without a mapping are only attributed if they're named exactly like a top-level attribute of `configType`.
+func fieldAttributePath(field string, fieldAttributes apiFieldAttributes, configType cty.Type) (cty.Path, bool) {
+	steps := strings.Split(field, ".")
+	var indexes []int
+	for i, step := range steps {
+		if index, err := strconv.Atoi(step); err == nil {
+			indexes = append(indexes, index)
+			steps[i] = "*"
+		}
+	}
+
+	attribute, ok := fieldAttributes[strings.Join(steps, ".")]
+	if !ok {
+		if len(steps) != 1 || !configType.IsObjectType() || !configType.HasAttribute(field) {
+			return nil, false
+		}
+		attribute = field
+	}
+
+	var path cty.Path
+	for _, step := range strings.Split(attribute, ".") {
+		if index, err := strconv.Atoi(step); err == nil {
+			path = path.IndexInt(index)
+		} else if step != "*" {
+			path = path.GetAttr(step)
+		} else if len(indexes) > 0 {
+			path = path.IndexInt(indexes[0])
+			indexes = indexes[1:]
+		} else {
+			return nil, false
+		}
+	}
+	return path, true
+}
+
+func handleNotFoundError(err error, d *schema.ResourceData) diag.Diagnostics {
+	if isNotFoundError(err) {
 		// the resource no longer exists, so reset its ID so Terraform will
 		// generate a plan that recreates it
 		d.SetId("")
@@ -43,6 +148,104 @@ func handleNotFoundError(err error, d *schema.ResourceData) diag.Diagnostics {
 	return diag.FromErr(err)
 }
 
+// The resources that a service token, which is scoped to a single config, is able to manage
+var configScopedResources = map[string]bool{
+	"doppler_secret": true,
+	// Secret notes are project-scoped rather than workplace-level, so whether a service token can set them is left to the API
+	"doppler_secret_note": true,
+}
+
+// The data sources that a service token is able to read
+var configScopedDataSources = map[string]bool{
+	"doppler_secrets":    true,
+	"doppler_token_info": true,
+}
+
+// requireWorkplaceToken wraps a resource's CustomizeDiff to fail the plan when the provider's validated token is a service token.
+func requireWorkplaceToken(resourceType string, customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if err := checkWorkplaceToken(resourceType, m); err != nil {
+			return err
+		}
+		if customizeDiff == nil {
+			return nil
+		}
+		return customizeDiff(ctx, d, m)
+	}
+}
+
+// requireWorkplaceTokenRead wraps a data source's ReadContext to fail before any request when the provider's validated token is a service token.
+func requireWorkplaceTokenRead(dataSourceType string, readContext schema.ReadContextFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := checkWorkplaceToken(dataSourceType, m); err != nil {
+			return diag.FromErr(err)
+		}
+		return readContext(ctx, d, m)
+	}
+}
+
+func checkWorkplaceToken(typeName string, m interface{}) error {
+	if client, ok := m.(APIClient); ok && client.TokenInfo != nil && !client.TokenInfo.isWorkplaceToken() {
+		return fmt.Errorf("%s requires a workplace-level Doppler token (e.g. a personal or service account token), but the provider is configured with a service token, which is scoped to a single config", typeName)
+	}
+	return nil
+}
+
+// resolveDefaultProjectConfig sets an omitted `project` or `config` to the provider's `default_project` or `default_config`.
+// The resolved values are persisted in state, so changing a default replaces the resources that rely on it.
+func resolveDefaultProjectConfig(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	client := m.(APIClient)
+	defaults := []struct {
+		attribute       string
+		value           string
+		providerSetting string
+	}{
+		{"project", client.DefaultProject, "default_project"},
+		{"config", client.DefaultConfig, "default_config"},
+	}
+	for _, defaultValue := range defaults {
+		if !d.GetRawConfig().GetAttr(defaultValue.attribute).IsNull() {
+			continue
+		}
+		if defaultValue.value == "" {
+			return fmt.Errorf("%q must be set, or the provider must be configured with %q", defaultValue.attribute, defaultValue.providerSetting)
+		}
+		if err := d.SetNew(defaultValue.attribute, defaultValue.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The timeouts of resources whose operations can be slow, e.g. syncing thousands of secrets or connecting to a database
+func slowResourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(10 * time.Minute),
+		Read:   schema.DefaultTimeout(5 * time.Minute),
+		Update: schema.DefaultTimeout(10 * time.Minute),
+		Delete: schema.DefaultTimeout(10 * time.Minute),
+	}
+}
+
+// withSlowRequests bounds the requests of a resource whose requests can take minutes (e.g. creating a sync) by the
+// deadlines of its operations, which are set by its `timeouts`, instead of defaultRequestTimeout.
+// The resource should declare slowResourceTimeouts.
+func withSlowRequests(resource *schema.Resource) *schema.Resource {
+	wrap := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return f(withOperationTimeout(ctx), d, m)
+		}
+	}
+	resource.CreateContext = wrap(resource.CreateContext)
+	resource.ReadContext = wrap(resource.ReadContext)
+	resource.UpdateContext = wrap(resource.UpdateContext)
+	resource.DeleteContext = wrap(resource.DeleteContext)
+	return resource
+}
+
 func isJWTShaped(s string) bool {
 	return strings.Count(s, ".") == 2
 }
@@ -0,0 +1,110 @@
+package doppler
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFieldAttributePath(t *testing.T) {
+	configType := cty.Object(map[string]cty.Type{
+		"name":        cty.String,
+		"project":     cty.String,
+		"trusted_ips": cty.Set(cty.String),
+		"credentials": cty.List(cty.Object(map[string]cty.Type{"password": cty.String})),
+	})
+	fieldAttributes := apiFieldAttributes{
+		"ip":                     "trusted_ips",
+		"credentials.*.PASSWORD": "credentials.*.password",
+		"secret_names.PASSWORD":  "secret_names.0.password",
+		"parameters.projectId":   "project_id",
+	}
+
+	tests := []struct {
+		name   string
+		field  string
+		want   cty.Path
+		wantOk bool
+	}{
+		{name: "mapped", field: "ip", want: cty.GetAttrPath("trusted_ips"), wantOk: true},
+		{name: "mapped index", field: "credentials.1.PASSWORD", want: cty.GetAttrPath("credentials").IndexInt(1).GetAttr("password"), wantOk: true},
+		{name: "mapped block", field: "secret_names.PASSWORD", want: cty.GetAttrPath("secret_names").IndexInt(0).GetAttr("password"), wantOk: true},
+		{name: "mapped nested parameter", field: "parameters.projectId", want: cty.GetAttrPath("project_id"), wantOk: true},
+		{name: "same name as an attribute", field: "name", want: cty.GetAttrPath("name"), wantOk: true},
+		{name: "unknown attribute", field: "managedPolicyArns", wantOk: false},
+		{name: "unmapped nested field", field: "credentials.0.USERNAME", wantOk: false},
+		{name: "unmapped nested field of an attribute", field: "project.slug", wantOk: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := fieldAttributePath(test.field, fieldAttributes, configType)
+			if ok != test.wantOk {
+				t.Fatalf("got ok %t, want %t", ok, test.wantOk)
+			}
+			if ok && !got.Equals(test.want) {
+				t.Errorf("got %#v, want %#v", got, test.want)
+			}
+		})
+	}
+
+	t.Run("unknown config type", func(t *testing.T) {
+		if _, ok := fieldAttributePath("name", nil, cty.DynamicPseudoType); ok {
+			t.Errorf("attributed a field without a mapping or config type")
+		}
+		if got, ok := fieldAttributePath("ip", fieldAttributes, cty.DynamicPseudoType); !ok || !got.Equals(cty.GetAttrPath("trusted_ips")) {
+			t.Errorf("got %#v, want the mapped attribute", got)
+		}
+	})
+}
+
+func TestAPIErrorDiagnostics(t *testing.T) {
+	validationError := typedAPIError(&APIError{
+		Message: "Invalid trusted IP",
+		Response: &APIResponse{
+			HTTPResponse: &http.Response{StatusCode: 400},
+			Body:         []byte(`{"data":{"errors":[{"field":"ip","message":"must be a CIDR range"},{"field":"project","message":"not found"},{"field":"limit","message":"exceeded"}]}}`),
+		},
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceTrustedIPs().Schema, map[string]interface{}{
+		"project":     "backend",
+		"config":      "dev",
+		"trusted_ips": []interface{}{"10.0.0.0/8"},
+	})
+	diags := apiErrorDiagnostics(validationError, d, trustedIPsFieldAttributes)
+	if len(diags) != 3 {
+		t.Fatalf("got %d diagnostics, want 3: %#v", len(diags), diags)
+	}
+	for _, diagnostic := range diags {
+		if diagnostic.Severity != diag.Error {
+			t.Errorf("got severity %v, want an error", diagnostic.Severity)
+		}
+		// The top-level message is kept as the summary of every field error
+		if diagnostic.Summary != validationError.Error() {
+			t.Errorf("got summary %q, want %q", diagnostic.Summary, validationError.Error())
+		}
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("trusted_ips")) || diags[0].Detail != "must be a CIDR range" {
+		t.Errorf("got %#v, want an error for trusted_ips", diags[0])
+	}
+	if !diags[1].AttributePath.Equals(cty.GetAttrPath("project")) || diags[1].Detail != "not found" {
+		t.Errorf("got %#v, want an error for project", diags[1])
+	}
+	if diags[2].AttributePath != nil || diags[2].Detail != "limit: exceeded" {
+		t.Errorf("got %#v, want an unattributed error naming the field", diags[2])
+	}
+
+	t.Run("without field errors", func(t *testing.T) {
+		err := typedAPIError(&APIError{
+			Message:  "Invalid request",
+			Response: &APIResponse{HTTPResponse: &http.Response{StatusCode: 400}, Body: []byte(`{}`)},
+		})
+		diags := apiErrorDiagnostics(err, d, trustedIPsFieldAttributes)
+		if len(diags) != 1 || diags[0].Summary != err.Error() || diags[0].AttributePath != nil {
+			t.Errorf("got %#v, want a single unattributed error", diags)
+		}
+	})
+}
@@ -0,0 +1,217 @@
+package doppler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAuditLogStream() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAuditLogStreamCreate,
+		ReadContext:   resourceAuditLogStreamRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		UpdateContext: resourceAuditLogStreamUpdate,
+		DeleteContext: resourceAuditLogStreamDelete,
+		CustomizeDiff: validateAuditLogStreamToken,
+		Schema: map[string]*schema.Schema{
+			"slug": {
+				Description: "The slug of the audit log stream",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The name of the audit log stream",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"type": {
+				Description:  "The type of destination that workplace events are streamed to. Valid values: https, splunk_hec, datadog",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"https", "splunk_hec", "datadog"}, false),
+			},
+			"url": {
+				Description:  "The URL that workplace events are delivered to (e.g. the Splunk HEC endpoint or Datadog log intake URL)",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"token": {
+				Description: "The token used to authenticate with the destination (the Splunk HEC token or Datadog API key). Required for `splunk_hec` and `datadog` streams",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"headers": {
+				Description: "Additional HTTP headers sent with each delivery, such as an `Authorization` header for `https` streams",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:  true,
+				Sensitive: true,
+			},
+			"enabled": {
+				Description: "Whether events are streamed to the destination. Defaults to true",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+// validateAuditLogStreamToken requires a token for `splunk_hec` and `datadog` streams so that a missing token fails at plan time.
+func validateAuditLogStreamToken(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("token") {
+		return nil
+	}
+	streamType := d.Get("type").(string)
+	if streamType != "https" && d.Get("token").(string) == "" {
+		return fmt.Errorf("token is required for %s audit log streams", streamType)
+	}
+	return nil
+}
+
+func resourceAuditLogStreamCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	streamType := d.Get("type").(string)
+	name := d.Get("name").(string)
+	url := d.Get("url").(string)
+	token := d.Get("token").(string)
+	enabled := d.Get("enabled").(bool)
+
+	options := AuditLogStreamOptionalParameters{Name: &name, Url: &url, Enabled: &enabled, Headers: auditLogStreamHeaders(d)}
+	if token != "" {
+		options.Token = &token
+	}
+
+	stream, err := client.CreateAuditLogStream(ctx, streamType, &options)
+	if err != nil {
+		return apiErrorDiagnostics(err, d, nil)
+	}
+
+	d.SetId(stream.Slug)
+
+	if err = updateAuditLogStreamState(d, stream); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceAuditLogStreamUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	slug := d.Id()
+
+	options := AuditLogStreamOptionalParameters{}
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		options.Name = &name
+	}
+
+	if d.HasChange("url") {
+		url := d.Get("url").(string)
+		options.Url = &url
+	}
+
+	if d.HasChange("token") {
+		token := d.Get("token").(string)
+		options.Token = &token
+	}
+
+	if d.HasChange("headers") {
+		options.Headers = auditLogStreamHeaders(d)
+	}
+
+	if d.HasChange("enabled") {
+		enabled := d.Get("enabled").(bool)
+		options.Enabled = &enabled
+	}
+
+	stream, err := client.UpdateAuditLogStream(ctx, slug, &options)
+	if err != nil {
+		return apiErrorDiagnostics(err, d, nil)
+	}
+
+	if err = updateAuditLogStreamState(d, stream); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceAuditLogStreamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	slug := d.Id()
+
+	stream, err := client.GetAuditLogStream(ctx, slug)
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	if err = updateAuditLogStreamState(d, stream); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceAuditLogStreamDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	slug := d.Id()
+
+	if err := client.DeleteAuditLogStream(ctx, slug); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// updateAuditLogStreamState sets the fields returned by the API. The token and headers are write-only, so they're left as configured.
+func updateAuditLogStreamState(d *schema.ResourceData, stream *AuditLogStream) error {
+	if err := d.Set("slug", stream.Slug); err != nil {
+		return err
+	}
+
+	if err := d.Set("name", stream.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("type", stream.Type); err != nil {
+		return err
+	}
+
+	if err := d.Set("url", stream.Url); err != nil {
+		return err
+	}
+
+	if err := d.Set("enabled", stream.Enabled); err != nil {
+		return err
+	}
+	return nil
+}
+
+func auditLogStreamHeaders(d *schema.ResourceData) map[string]string {
+	headers := map[string]string{}
+	for k, v := range d.Get("headers").(map[string]interface{}) {
+		headers[k] = v.(string)
+	}
+	return headers
+}
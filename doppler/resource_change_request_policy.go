@@ -164,7 +164,7 @@ func resourceChangeRequestPolicyCreate(ctx context.Context, d *schema.ResourceDa
 
 	policy, err := client.CreateChangeRequestPolicy(ctx, &payload)
 	if err != nil {
-		diags = append(diags, diag.FromErr(err)...)
+		diags = append(diags, apiErrorDiagnostics(err, d, nil)...)
 		return diags
 	}
 
@@ -184,7 +184,7 @@ func resourceChangeRequestPolicyUpdate(ctx context.Context, d *schema.ResourceDa
 
 	policy, err := client.UpdateChangeRequestPolicy(ctx, &payload)
 	if err != nil {
-		diags = append(diags, diag.FromErr(err)...)
+		diags = append(diags, apiErrorDiagnostics(err, d, nil)...)
 		return diags
 	}
 
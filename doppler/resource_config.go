@@ -11,7 +11,7 @@ import (
 )
 
 func resourceConfig() *schema.Resource {
-	return &schema.Resource{
+	return withSlowRequests(&schema.Resource{
 		CreateContext: resourceConfigCreate,
 		ReadContext:   resourceConfigRead,
 		Importer: &schema.ResourceImporter{
@@ -19,6 +19,7 @@ func resourceConfig() *schema.Resource {
 		},
 		UpdateContext: resourceConfigUpdate,
 		DeleteContext: resourceConfigDelete,
+		Timeouts:      slowResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"project": {
 				Description: "The name of the Doppler project where the config is located",
@@ -59,7 +60,7 @@ func resourceConfig() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func inheritsArgToDescriptors(inherits []interface{}) ([]ConfigDescriptor, error) {
@@ -111,7 +112,7 @@ func resourceConfigCreate(ctx context.Context, d *schema.ResourceData, m interfa
 			// Configs are always created as not inheritable, and inheritability cannot be specified during the creation request.
 			config, err = client.UpdateConfigInheritable(ctx, project, name, inheritable)
 			if err != nil {
-				return diag.FromErr(err)
+				return apiErrorDiagnostics(err, d, nil)
 			}
 		}
 		return nil
@@ -125,7 +126,7 @@ func resourceConfigCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		if !reflect.DeepEqual(config.Inherits, descriptors) {
 			config, err = client.UpdateConfigInherits(ctx, project, name, descriptors)
 			if err != nil {
-				return diag.FromErr(err)
+				return apiErrorDiagnostics(err, d, nil)
 			}
 		}
 		return nil
@@ -169,7 +170,7 @@ func resourceConfigUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	if d.HasChange("name") {
 		config, err := client.RenameConfig(ctx, project, currentName, newName)
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err, d, nil)
 		}
 		d.SetId(config.getResourceId())
 		if err = d.Set("descriptor", fmt.Sprintf("%s.%s", config.Project, config.Name)); err != nil {
@@ -181,7 +182,7 @@ func resourceConfigUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		if d.HasChange("inheritable") {
 			_, err = client.UpdateConfigInheritable(ctx, project, newName, d.Get("inheritable").(bool))
 			if err != nil {
-				return diag.FromErr(err)
+				return apiErrorDiagnostics(err, d, nil)
 			}
 		}
 		return nil
@@ -191,14 +192,14 @@ func resourceConfigUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		if d.HasChange("inherits") {
 			inherits := d.Get("inherits").([]interface{})
 
-			descriptors, nil := inheritsArgToDescriptors(inherits)
+			descriptors, err := inheritsArgToDescriptors(inherits)
 			if err != nil {
 				return diag.FromErr(err)
 			}
 
 			_, err = client.UpdateConfigInherits(ctx, project, newName, descriptors)
 			if err != nil {
-				return diag.FromErr(err)
+				return apiErrorDiagnostics(err, d, nil)
 			}
 		}
 		return nil
@@ -0,0 +1,242 @@
+package doppler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type DynamicSecretParametersBuilderFunc = func(d *schema.ResourceData) DynamicSecretParameters
+
+type ResourceDynamicSecretBuilder struct {
+	ParametersSchema  map[string]*schema.Schema
+	ParametersBuilder DynamicSecretParametersBuilderFunc
+	// Maps each parameter attribute to the API parameter it's sent as, used to read parameters back and attribute validation errors
+	ParameterFields map[string]string
+}
+
+// resourceDynamicSecret returns a schema resource object for the DynamicSecret model.
+func (builder ResourceDynamicSecretBuilder) Build() *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"integration": {
+			Description: "The slug of the integration to use for this dynamic secret",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"project": {
+			Description: "The name of the Doppler project",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"config": {
+			Description: "The name of the Doppler config",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the dynamic secret",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    false,
+		},
+		"default_ttl_sec": {
+			Description:  "How long, in seconds, a lease is valid for when no TTL is requested",
+			Type:         schema.TypeInt,
+			Required:     true,
+			ForceNew:     false,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"max_ttl_sec": {
+			Description:  "The maximum TTL, in seconds, that may be requested for a lease",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     false,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+
+	// NOTE: We set ForceNew=true for parameters because only `name` and the TTL settings are allowed to be changed.
+
+	for name, subschema := range builder.ParametersSchema {
+		s := *subschema
+		s.ForceNew = true
+		resourceSchema[name] = &s
+	}
+
+	return &schema.Resource{
+		CreateContext: builder.CreateContextFunc(),
+		ReadContext:   builder.ReadContextFunc(),
+		UpdateContext: builder.UpdateContextFunc(),
+		DeleteContext: builder.DeleteContextFunc(),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDynamicSecretImport,
+		},
+		Schema:        resourceSchema,
+		CustomizeDiff: validateDynamicSecretTTL,
+	}
+}
+
+func resourceDynamicSecretImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	split := strings.Split(d.Id(), `.`)
+	if len(split) != 3 {
+		return []*schema.ResourceData{d}, errors.New("Dynamic secret id not in the format <project-name>.<config-name>.<dynamic-secret-slug>")
+	}
+	if err := d.Set("project", split[0]); err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	if err := d.Set("config", split[1]); err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	d.SetId(split[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// validateDynamicSecretTTL ensures that `max_ttl_sec` is not lower than `default_ttl_sec` at plan time.
+func validateDynamicSecretTTL(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("default_ttl_sec") || !d.NewValueKnown("max_ttl_sec") {
+		return nil
+	}
+	defaultTtlSec := d.Get("default_ttl_sec").(int)
+	maxTtlSec := d.Get("max_ttl_sec").(int)
+	if maxTtlSec != 0 && maxTtlSec < defaultTtlSec {
+		return fmt.Errorf("max_ttl_sec (%d) must be greater than or equal to default_ttl_sec (%d)", maxTtlSec, defaultTtlSec)
+	}
+	return nil
+}
+
+// fieldAttributes maps the API parameters of the dynamic secret, which are sent nested under `parameters`, to their attributes.
+func (builder ResourceDynamicSecretBuilder) fieldAttributes() apiFieldAttributes {
+	fieldAttributes := apiFieldAttributes{}
+	for attribute, parameter := range builder.ParameterFields {
+		fieldAttributes["parameters."+parameter] = attribute
+	}
+	return fieldAttributes
+}
+
+func (builder ResourceDynamicSecretBuilder) CreateContextFunc() schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(APIClient)
+
+		var diags diag.Diagnostics
+		integ := d.Get("integration").(string)
+		config := d.Get("config").(string)
+		project := d.Get("project").(string)
+		name := d.Get("name").(string)
+		defaultTtlSec := d.Get("default_ttl_sec").(int)
+		maxTtlSec := d.Get("max_ttl_sec").(int)
+		parameters := map[string]interface{}{}
+
+		if builder.ParametersBuilder != nil {
+			parameters = builder.ParametersBuilder(d)
+		}
+
+		ds, err := client.CreateDynamicSecret(ctx, name, defaultTtlSec, maxTtlSec, parameters, config, project, integ)
+		if err != nil {
+			return apiErrorDiagnostics(err, d, builder.fieldAttributes())
+		}
+
+		d.SetId(ds.Slug)
+
+		return diags
+	}
+}
+
+func (builder ResourceDynamicSecretBuilder) ReadContextFunc() schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(APIClient)
+
+		var diags diag.Diagnostics
+
+		slug := d.Id()
+		config := d.Get("config").(string)
+		project := d.Get("project").(string)
+
+		ds, err := client.GetDynamicSecret(ctx, config, project, slug)
+		if err != nil {
+			return handleNotFoundError(err, d)
+		}
+
+		if err = d.Set("integration", ds.Integration.Slug); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = d.Set("project", ds.Project); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = d.Set("config", ds.Config); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = d.Set("name", ds.Name); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = d.Set("default_ttl_sec", ds.DefaultTtlSec); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = d.Set("max_ttl_sec", ds.MaxTtlSec); err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Parameters are only read back when the API returns them, otherwise the configured values are kept
+		if err = setDynamicSecretParameters(d, ds.Parameters, builder.ParameterFields); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return diags
+	}
+}
+
+func (builder ResourceDynamicSecretBuilder) UpdateContextFunc() schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(APIClient)
+
+		var diags diag.Diagnostics
+		slug := d.Id()
+		config := d.Get("config").(string)
+		project := d.Get("project").(string)
+		name := d.Get("name").(string)
+		defaultTtlSec := d.Get("default_ttl_sec").(int)
+		maxTtlSec := d.Get("max_ttl_sec").(int)
+
+		if d.HasChangesExcept("name", "default_ttl_sec", "max_ttl_sec") {
+			// For good measure: This should not be possible because ForceNew is set for all other fields in the schema.
+			return diag.Errorf("Only name, default_ttl_sec, and max_ttl_sec can be changed for dynamic secrets")
+		}
+
+		_, err := client.UpdateDynamicSecret(ctx, name, defaultTtlSec, maxTtlSec, config, project, slug)
+		if err != nil {
+			return apiErrorDiagnostics(err, d, builder.fieldAttributes())
+		}
+
+		return diags
+	}
+}
+
+func (builder ResourceDynamicSecretBuilder) DeleteContextFunc() schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(APIClient)
+
+		var diags diag.Diagnostics
+
+		slug := d.Id()
+		config := d.Get("config").(string)
+		project := d.Get("project").(string)
+		if err := client.DeleteDynamicSecret(ctx, slug, config, project); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return diags
+	}
+}
@@ -0,0 +1,95 @@
+package doppler
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDynamicSecretAWSIAMUser() *schema.Resource {
+	builder := ResourceDynamicSecretBuilder{
+		ParametersSchema: map[string]*schema.Schema{
+			"managed_policy_arns": {
+				Description: "ARNs of the managed IAM policies to attach to each leased IAM user",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+			"inline_policy": {
+				Description:  "An inline IAM policy document (JSON) to attach to each leased IAM user",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"groups": {
+				Description: "IAM groups to add each leased IAM user to",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+			"permissions_boundary_arn": {
+				Description: "The ARN of the IAM policy to use as the permissions boundary for each leased IAM user",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+		ParametersBuilder: func(d *schema.ResourceData) DynamicSecretParameters {
+			return map[string]interface{}{
+				"managedPolicyArns":      d.Get("managed_policy_arns").(*schema.Set).List(),
+				"inlinePolicy":           d.Get("inline_policy"),
+				"groups":                 d.Get("groups").(*schema.Set).List(),
+				"permissionsBoundaryArn": d.Get("permissions_boundary_arn"),
+			}
+		},
+		ParameterFields: map[string]string{
+			"managed_policy_arns":      "managedPolicyArns",
+			"inline_policy":            "inlinePolicy",
+			"groups":                   "groups",
+			"permissions_boundary_arn": "permissionsBoundaryArn",
+		},
+	}
+	return builder.Build()
+}
+
+func resourceDynamicSecretGCPServiceAccount() *schema.Resource {
+	builder := ResourceDynamicSecretBuilder{
+		ParametersSchema: map[string]*schema.Schema{
+			"service_account": {
+				Description: "The email of the service account that leased access tokens impersonate",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"scopes": {
+				Description: "OAuth scopes to request for leased access tokens",
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    1,
+				Required:    true,
+			},
+		},
+		ParametersBuilder: func(d *schema.ResourceData) DynamicSecretParameters {
+			return map[string]interface{}{
+				"serviceAccount": d.Get("service_account"),
+				"scopes":         d.Get("scopes"),
+			}
+		},
+		ParameterFields: map[string]string{
+			"service_account": "serviceAccount",
+			"scopes":          "scopes",
+		},
+	}
+	return builder.Build()
+}
+
+// setDynamicSecretParameters sets each attribute from the API parameter it maps to, skipping parameters the API omitted.
+func setDynamicSecretParameters(d *schema.ResourceData, parameters DynamicSecretParameters, parameterFields map[string]string) error {
+	for attribute, parameter := range parameterFields {
+		value, ok := parameters[parameter]
+		if !ok || value == nil {
+			continue
+		}
+		if err := d.Set(attribute, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -55,7 +55,7 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	environment, err := client.CreateEnvironment(ctx, project, slug, name, personalConfigs)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 
 	d.SetId(environment.getResourceId())
@@ -78,7 +78,7 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 	environment, err := client.UpdateEnvironment(ctx, project, currentSlug, newSlug, newName, newPersonalConfigs)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 	d.SetId(environment.getResourceId())
 	return diags
@@ -31,7 +31,7 @@ func resourceExternalIdCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	externalId, err := client.CreateExternalId(ctx, integrationType)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 
 	d.SetId(externalId)
@@ -64,7 +64,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, m interfac
 
 	group, err := client.CreateGroup(ctx, name, defaultProjectRole, workplaceRole)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 
 	d.SetId(group.Slug)
@@ -111,7 +111,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 
 	group, err := client.UpdateGroup(ctx, slug, name, defaultProjectRole, workplaceRole)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 
 	err = updateGroupState(d, group)
@@ -62,7 +62,7 @@ func (builder ResourceGroupMemberBuilder) CreateContextFunc() schema.CreateConte
 
 		err = client.CreateGroupMember(ctx, group, builder.MemberType, *memberSlug)
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err, d, nil)
 		}
 
 		d.SetId(getGroupMemberId(group, builder.MemberType, *memberSlug))
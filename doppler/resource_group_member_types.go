@@ -25,3 +25,23 @@ func resourceGroupMemberWorkplaceUser() *schema.Resource {
 	}
 	return builder.Build()
 }
+
+func resourceGroupMemberServiceAccount() *schema.Resource {
+	builder := ResourceGroupMemberBuilder{
+		MemberType: "service_account",
+		DataSchema: map[string]*schema.Schema{
+			"service_account_slug": {
+				Description: "The slug of the Doppler service account",
+				Type:        schema.TypeString,
+				Required:    true,
+				// Members cannot be moved directly from one group to another, they must be re-created
+				ForceNew: true,
+			},
+		},
+		GetMemberSlugFunc: func(ctx context.Context, d *schema.ResourceData, m interface{}) (*string, error) {
+			serviceAccountSlug := d.Get("service_account_slug").(string)
+			return &serviceAccountSlug, nil
+		},
+	}
+	return builder.Build()
+}
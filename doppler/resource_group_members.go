@@ -7,12 +7,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// Maps each Doppler member type to the resource attribute that lists members of that type
+var groupMembersAttributes = map[string]string{
+	"workplace_user":  "user_slugs",
+	"service_account": "service_account_slugs",
+}
+
 func resourceGroupMembers() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceGroupMembersCreate,
 		ReadContext:   resourceGroupMembersRead,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceGroupMembersImport,
 		},
 		UpdateContext: resourceGroupMembersUpdate,
 		DeleteContext: resourceGroupMembersDelete,
@@ -25,26 +31,77 @@ func resourceGroupMembers() *schema.Resource {
 				ForceNew: true,
 			},
 			"user_slugs": {
-				Description: "A list of user slugs in the group",
+				Description: "A list of user slugs in the group. At least one of `user_slugs` or `service_account_slugs` must be set, set it to an empty list to remove all members from an authoritative group",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:     true,
+				AtLeastOneOf: []string{"user_slugs", "service_account_slugs"},
+			},
+			"service_account_slugs": {
+				Description: "A list of service account slugs in the group",
 				Type:        schema.TypeSet,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Required: true,
+				Optional:     true,
+				AtLeastOneOf: []string{"user_slugs", "service_account_slugs"},
+			},
+			"authoritative": {
+				Description: "If true (the default), all existing memberships of the group are replaced by the listed members. If false, only the listed members are managed and any other memberships are left untouched",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
 			},
 		},
 	}
 }
 
+func resourceGroupMembersImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	// Imported memberships are managed authoritatively so that all existing members are read into state
+	if err := d.Set("authoritative", true); err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceGroupMembersCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(APIClient)
 	var diags diag.Diagnostics
 
 	groupSlug := d.Get("group_slug").(string)
+
+	if !d.Get("authoritative").(bool) {
+		currentMembers, err := listAllGroupMembers(ctx, client, groupSlug)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		existingMembers := map[GroupMember]bool{}
+		for _, member := range currentMembers {
+			existingMembers[member] = true
+		}
+
+		for _, member := range groupMembersFromSets(d.Get("user_slugs").(*schema.Set), d.Get("service_account_slugs").(*schema.Set)) {
+			if existingMembers[member] {
+				continue
+			}
+			if err := client.CreateGroupMember(ctx, groupSlug, member.Type, member.Slug); err != nil {
+				return apiErrorDiagnostics(err, d, nil)
+			}
+		}
+
+		d.SetId(groupSlug)
+
+		return diags
+	}
+
 	// Just fetch one member to see if any exist
 	currentMembers, err := client.GetGroupMembers(ctx, groupSlug, PageOptions{Page: 1, PerPage: 1})
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 
 	if len(currentMembers) > 0 {
@@ -68,16 +125,44 @@ func resourceGroupMembersUpdate(ctx context.Context, d *schema.ResourceData, m i
 
 	var diags diag.Diagnostics
 	groupSlug := d.Get("group_slug").(string)
-	userSlugs := d.Get("user_slugs").(*schema.Set).List()
 
-	members := make([]GroupMember, len(userSlugs))
-	for i, v := range userSlugs {
-		members[i] = GroupMember{Type: "workplace_user", Slug: v.(string)}
+	if !d.Get("authoritative").(bool) {
+		oldUserSlugs, newUserSlugs := d.GetChange("user_slugs")
+		oldServiceAccountSlugs, newServiceAccountSlugs := d.GetChange("service_account_slugs")
+
+		removedMembers := groupMembersFromSets(
+			oldUserSlugs.(*schema.Set).Difference(newUserSlugs.(*schema.Set)),
+			oldServiceAccountSlugs.(*schema.Set).Difference(newServiceAccountSlugs.(*schema.Set)),
+		)
+		// Members that are no longer listed when switching from authoritative were tracked only because
+		// they were in the group, so they're left untouched like any other unmanaged membership
+		if d.HasChange("authoritative") {
+			removedMembers = nil
+		}
+		for _, member := range removedMembers {
+			if err := client.DeleteGroupMember(ctx, groupSlug, member.Type, member.Slug); err != nil {
+				return apiErrorDiagnostics(err, d, nil)
+			}
+		}
+
+		addedMembers := groupMembersFromSets(
+			newUserSlugs.(*schema.Set).Difference(oldUserSlugs.(*schema.Set)),
+			newServiceAccountSlugs.(*schema.Set).Difference(oldServiceAccountSlugs.(*schema.Set)),
+		)
+		for _, member := range addedMembers {
+			if err := client.CreateGroupMember(ctx, groupSlug, member.Type, member.Slug); err != nil {
+				return apiErrorDiagnostics(err, d, nil)
+			}
+		}
+
+		return diags
 	}
 
+	members := groupMembersFromSets(d.Get("user_slugs").(*schema.Set), d.Get("service_account_slugs").(*schema.Set))
+
 	err := client.ReplaceGroupMembers(ctx, groupSlug, members)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 
 	return diags
@@ -89,40 +174,38 @@ func resourceGroupMembersRead(ctx context.Context, d *schema.ResourceData, m int
 	var diags diag.Diagnostics
 
 	groupSlug := d.Id()
+	authoritative := d.Get("authoritative").(bool)
 
-	perPage := 1000
-	maxPages := 5
-
-	members := []GroupMember{}
-
-	for page := 1; page <= maxPages; page++ {
-		pageMembers, err := client.GetGroupMembers(ctx, groupSlug, PageOptions{Page: page, PerPage: perPage})
-		if err != nil {
-			return handleNotFoundError(err, d)
-		}
-		members = append(members, pageMembers...)
-		if len(pageMembers) < perPage {
-			break
-		} else if page == maxPages {
-			return diag.Errorf("Exceeded max number of group members")
-		}
+	members, err := listAllGroupMembers(ctx, client, groupSlug)
+	if err != nil {
+		return handleNotFoundError(err, d)
 	}
 
-	userSlugs := []string{}
+	slugs := map[string][]string{}
 	for _, v := range members {
-		if v.Type == "workplace_user" {
-			userSlugs = append(userSlugs, v.Slug)
-		} else {
+		attribute, ok := groupMembersAttributes[v.Type]
+		if !ok {
+			if !authoritative {
+				// Non-authoritative memberships ignore any members not managed by this resource
+				continue
+			}
 			return diag.Errorf("Actor type %s is not supported by this plugin version", v.Type)
 		}
+		// Non-authoritative memberships only track the listed members that still exist
+		if !authoritative && !d.Get(attribute).(*schema.Set).Contains(v.Slug) {
+			continue
+		}
+		slugs[attribute] = append(slugs[attribute], v.Slug)
 	}
 
 	if err := d.Set("group_slug", groupSlug); err != nil {
 		return diag.FromErr((err))
 	}
 
-	if err := d.Set("user_slugs", userSlugs); err != nil {
-		return diag.FromErr((err))
+	for _, attribute := range groupMembersAttributes {
+		if err := d.Set(attribute, slugs[attribute]); err != nil {
+			return diag.FromErr((err))
+		}
 	}
 
 	return diags
@@ -134,6 +217,16 @@ func resourceGroupMembersDelete(ctx context.Context, d *schema.ResourceData, m i
 	var diags diag.Diagnostics
 	groupSlug := d.Id()
 
+	if !d.Get("authoritative").(bool) {
+		for _, member := range groupMembersFromSets(d.Get("user_slugs").(*schema.Set), d.Get("service_account_slugs").(*schema.Set)) {
+			if err := client.DeleteGroupMember(ctx, groupSlug, member.Type, member.Slug); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		return diags
+	}
+
 	// Setting the members to an empty list effectively deletes the memberships
 	if err := client.ReplaceGroupMembers(ctx, groupSlug, []GroupMember{}); err != nil {
 		return diag.FromErr(err)
@@ -141,3 +234,14 @@ func resourceGroupMembersDelete(ctx context.Context, d *schema.ResourceData, m i
 
 	return diags
 }
+
+func groupMembersFromSets(userSlugs *schema.Set, serviceAccountSlugs *schema.Set) []GroupMember {
+	members := []GroupMember{}
+	for _, v := range userSlugs.List() {
+		members = append(members, GroupMember{Type: "workplace_user", Slug: v.(string)})
+	}
+	for _, v := range serviceAccountSlugs.List() {
+		members = append(members, GroupMember{Type: "service_account", Slug: v.(string)})
+	}
+	return members
+}
@@ -30,13 +30,14 @@ func (builder ResourceIntegrationBuilder) Build() *schema.Resource {
 		resourceSchema[name] = &s
 	}
 
-	return &schema.Resource{
+	return withSlowRequests(&schema.Resource{
 		CreateContext: builder.CreateContextFunc(),
 		ReadContext:   builder.ReadContextFunc(),
 		UpdateContext: builder.UpdateContextFunc(),
 		DeleteContext: builder.DeleteContextFunc(),
 		Schema:        resourceSchema,
-	}
+		Timeouts:      slowResourceTimeouts(),
+	})
 }
 
 func (builder ResourceIntegrationBuilder) CreateContextFunc() schema.CreateContextFunc {
@@ -49,7 +50,7 @@ func (builder ResourceIntegrationBuilder) CreateContextFunc() schema.CreateConte
 
 		integ, err := client.CreateIntegration(ctx, integData, name, builder.Type)
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err, d, nil)
 		}
 
 		d.SetId(integ.Slug)
@@ -84,7 +85,7 @@ func (builder ResourceIntegrationBuilder) UpdateContextFunc() schema.UpdateConte
 
 		_, err := client.UpdateIntegration(ctx, slug, name, data)
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err, d, nil)
 		}
 		return diags
 	}
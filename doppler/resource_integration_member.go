@@ -66,7 +66,7 @@ func (builder ResourceIntegrationMemberBuilder) CreateContextFunc() schema.Creat
 
 		member, err := client.CreateIntegrationMember(ctx, integration, builder.MemberType, *memberSlug, role)
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err, d, nil)
 		}
 
 		err = updateIntegrationMemberState(d, member)
@@ -95,7 +95,7 @@ func (builder ResourceIntegrationMemberBuilder) UpdateContextFunc() schema.Updat
 
 		member, err := client.UpdateIntegrationMember(ctx, integration, memberType, memberSlug, role)
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err, d, nil)
 		}
 
 		err = updateIntegrationMemberState(d, member)
@@ -40,7 +40,7 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	project, err := client.CreateProject(ctx, name, description)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 
 	d.SetId(project.Slug)
@@ -58,7 +58,7 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	project, err := client.UpdateProject(ctx, currentName, newName, description)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 	d.SetId(project.Slug)
 	return diags
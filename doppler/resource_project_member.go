@@ -80,7 +80,7 @@ func (builder ResourceProjectMemberBuilder) CreateContextFunc() schema.CreateCon
 
 		member, err := client.CreateProjectMember(ctx, project, builder.MemberType, *memberSlug, role, environments)
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err, d, nil)
 		}
 
 		err = updateProjectMemberState(d, member)
@@ -122,7 +122,7 @@ func (builder ResourceProjectMemberBuilder) UpdateContextFunc() schema.UpdateCon
 
 		member, err := client.UpdateProjectMember(ctx, project, memberType, memberSlug, role, environments)
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err, d, nil)
 		}
 
 		err = updateProjectMemberState(d, member)
@@ -78,7 +78,7 @@ func resourceProjectRoleCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	role, err := client.CreateProjectRole(ctx, name, permissions)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 
 	diags = append(diags, updateProjectRoleData(d, role)...)
@@ -98,7 +98,7 @@ func resourceProjectRoleUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 	role, err := client.UpdateProjectRole(ctx, identifier, newName, newPermissions)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 	diags = append(diags, updateProjectRoleData(d, role)...)
 	return diags
@@ -2,8 +2,10 @@ package doppler
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,6 +17,8 @@ type ResourceRotatedSecretBuilder struct {
 	ParametersBuilder  RotatedSecretParametersBuilderFunc
 	CredentialsSchema  map[string]*schema.Schema
 	CredentialsBuilder RotatedSecretCredentialsBuilderFunc
+	// Maps each `secret_names` attribute to the rotated credential field it names (e.g. "password" -> "PASSWORD")
+	SecretNameFields map[string]string
 }
 
 // resourceRotatedSecret returns a schema resource object for the RotatedSecret model.
@@ -50,9 +54,27 @@ func (builder ResourceRotatedSecretBuilder) Build() *schema.Resource {
 			Required:    true,
 			ForceNew:    false,
 		},
+		"rotate_trigger": {
+			Description: "An arbitrary map of values that, when changed, triggers an immediate rotation of the secret (similar to `replace_triggered_by`)",
+			Type:        schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional: true,
+		},
+		"last_rotated_at": {
+			Description: "The datetime that the secret was last rotated",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"next_rotation_at": {
+			Description: "The datetime that the secret is next scheduled to be rotated",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
 	}
 
-	// NOTE: We set ForceNew=true for parameters and credentials because only `name` and `rotation_period_sec` are allowed to be changed.
+	// NOTE: We set ForceNew=true for parameters and credentials because only `name`, `rotation_period_sec`, and `rotate_trigger` are allowed to be changed.
 	// This could have been defined by the caller but it's error-prone to declare it many times when it can be set once here.
 
 	for name, subschema := range builder.ParametersSchema {
@@ -67,13 +89,46 @@ func (builder ResourceRotatedSecretBuilder) Build() *schema.Resource {
 		resourceSchema[name] = &s
 	}
 
-	return &schema.Resource{
+	if len(builder.SecretNameFields) > 0 {
+		secretNamesSchema := map[string]*schema.Schema{}
+		for field := range builder.SecretNameFields {
+			secretNamesSchema[field] = &schema.Schema{
+				Description: fmt.Sprintf("The name of the Doppler secret that receives the rotated %s", field),
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			}
+		}
+		resourceSchema["secret_names"] = &schema.Schema{
+			Description: "The names of the Doppler secrets that receive the rotated credentials. Any omitted names are derived from the rotated secret's `name`",
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Elem: &schema.Resource{
+				Schema: secretNamesSchema,
+			},
+		}
+	}
+
+	return withSlowRequests(&schema.Resource{
 		CreateContext: builder.CreateContextFunc(),
 		ReadContext:   builder.ReadContextFunc(),
 		UpdateContext: builder.UpdateContextFunc(),
 		DeleteContext: builder.DeleteContextFunc(),
 		Schema:        resourceSchema,
-	}
+		Timeouts:      slowResourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("last_rotated_at", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChanges("rotate_trigger", "rotation_period_sec")
+			}),
+			customdiff.ComputedIf("next_rotation_at", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChanges("rotate_trigger", "rotation_period_sec")
+			}),
+		),
+	})
 }
 
 func (builder ResourceRotatedSecretBuilder) CreateContextFunc() schema.CreateContextFunc {
@@ -97,14 +152,19 @@ func (builder ResourceRotatedSecretBuilder) CreateContextFunc() schema.CreateCon
 		if builder.CredentialsBuilder != nil {
 			credentials = builder.CredentialsBuilder(d)
 		}
+		secretNames := builder.secretNames(d)
 
-		rs, err := client.CreateRotatedSecret(ctx, name, rotationPeriodSec, parameters, credentials, config, project, integ)
+		rs, err := client.CreateRotatedSecret(ctx, name, rotationPeriodSec, parameters, credentials, secretNames, config, project, integ)
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err, d, builder.fieldAttributes())
 		}
 
 		d.SetId(rs.Slug)
 
+		if err = updateRotatedSecretRotationState(d, rs); err != nil {
+			return diag.FromErr(err)
+		}
+
 		return diags
 	}
 }
@@ -144,6 +204,30 @@ func (builder ResourceRotatedSecretBuilder) ReadContextFunc() schema.ReadContext
 			return diag.FromErr(err)
 		}
 
+		if err = updateRotatedSecretRotationState(d, rs); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if len(builder.SecretNameFields) > 0 {
+			// The API may omit names (e.g. for rotated secrets created before they were configurable),
+			// keep the prior value for those so the ForceNew block doesn't replace the resource on every plan
+			priorSecretNames := map[string]interface{}{}
+			if rawSecretNames := d.Get("secret_names").([]interface{}); len(rawSecretNames) > 0 && rawSecretNames[0] != nil {
+				priorSecretNames = rawSecretNames[0].(map[string]interface{})
+			}
+			secretNames := map[string]interface{}{}
+			for field, key := range builder.SecretNameFields {
+				if secretName := rs.SecretNames[key]; secretName != "" {
+					secretNames[field] = secretName
+				} else {
+					secretNames[field] = priorSecretNames[field]
+				}
+			}
+			if err = d.Set("secret_names", []interface{}{secretNames}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
 		return diags
 	}
 }
@@ -159,20 +243,76 @@ func (builder ResourceRotatedSecretBuilder) UpdateContextFunc() schema.UpdateCon
 		name := d.Get("name").(string)
 		rotationPeriodSec := d.Get("rotation_period_sec").(int)
 
-		if d.HasChangesExcept("name", "rotation_period_sec") {
+		if d.HasChangesExcept("name", "rotation_period_sec", "rotate_trigger") {
 			// For good measure: This should not be possible because ForceNew is set for all other fields in the schema.
-			return diag.Errorf("Only name and rotation_period_sec can be changed for rotated secrets")
+			return diag.Errorf("Only name, rotation_period_sec, and rotate_trigger can be changed for rotated secrets")
 		}
 
-		_, err := client.UpdateRotatedSecret(ctx, name, rotationPeriodSec, config, project, slug)
-		if err != nil {
-			return diag.FromErr(err)
+		if d.HasChanges("name", "rotation_period_sec") {
+			rs, err := client.UpdateRotatedSecret(ctx, name, rotationPeriodSec, config, project, slug)
+			if err != nil {
+				return apiErrorDiagnostics(err, d, builder.fieldAttributes())
+			}
+			if err = updateRotatedSecretRotationState(d, rs); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if d.HasChange("rotate_trigger") {
+			rs, err := client.RotateRotatedSecret(ctx, config, project, slug)
+			if err != nil {
+				return apiErrorDiagnostics(err, d, builder.fieldAttributes())
+			}
+			if err = updateRotatedSecretRotationState(d, rs); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 
 		return diags
 	}
 }
 
+// fieldAttributes maps the API's credential fields (e.g. "PASSWORD") to the `credentials` and `secret_names` attributes that set them.
+func (builder ResourceRotatedSecretBuilder) fieldAttributes() apiFieldAttributes {
+	fieldAttributes := apiFieldAttributes{}
+	for attribute, field := range builder.SecretNameFields {
+		if _, ok := builder.CredentialsSchema["credentials"]; ok {
+			fieldAttributes["credentials.*."+field] = "credentials.*." + attribute
+		}
+		fieldAttributes["secret_names."+field] = "secret_names.0." + attribute
+	}
+	return fieldAttributes
+}
+
+// secretNames returns the configured `secret_names` keyed by credential field, omitting any names left for the API to derive.
+func (builder ResourceRotatedSecretBuilder) secretNames(d *schema.ResourceData) RotatedSecretNames {
+	secretNames := RotatedSecretNames{}
+	if len(builder.SecretNameFields) == 0 {
+		return secretNames
+	}
+	rawSecretNames := d.Get("secret_names").([]interface{})
+	if len(rawSecretNames) == 0 || rawSecretNames[0] == nil {
+		return secretNames
+	}
+	secretNamesMap := rawSecretNames[0].(map[string]interface{})
+	for field, key := range builder.SecretNameFields {
+		if secretName, ok := secretNamesMap[field].(string); ok && secretName != "" {
+			secretNames[key] = secretName
+		}
+	}
+	return secretNames
+}
+
+func updateRotatedSecretRotationState(d *schema.ResourceData, rs *RotatedSecret) error {
+	if err := d.Set("last_rotated_at", rs.LastRotatedAt); err != nil {
+		return err
+	}
+	if err := d.Set("next_rotation_at", rs.NextRotationAt); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (builder ResourceRotatedSecretBuilder) DeleteContextFunc() schema.DeleteContextFunc {
 
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -5,7 +5,13 @@ import (
 )
 
 func resourceRotatedSecretTwilio() *schema.Resource {
-	return ResourceRotatedSecretBuilder{}.Build()
+	builder := ResourceRotatedSecretBuilder{
+		SecretNameFields: map[string]string{
+			"sid":    "SID",
+			"secret": "SECRET",
+		},
+	}
+	return builder.Build()
 }
 
 func resourceRotatedSecretCloudflareTokens() *schema.Resource {
@@ -38,6 +44,9 @@ func resourceRotatedSecretCloudflareTokens() *schema.Resource {
 			}
 			return credentials
 		},
+		SecretNameFields: map[string]string{
+			"value": "VALUE",
+		},
 	}
 	return builder.Build()
 }
@@ -89,6 +98,10 @@ func resourceRotatedSecretMongoDBAtlas() *schema.Resource {
 			}
 			return credentials
 		},
+		SecretNameFields: map[string]string{
+			"username": "USERNAME",
+			"password": "PASSWORD",
+		},
 	}
 	return builder.Build()
 }
@@ -107,6 +120,9 @@ func resourceRotatedSecretOpenAI() *schema.Resource {
 				"projectId": d.Get("project_id"),
 			}
 		},
+		SecretNameFields: map[string]string{
+			"api_key": "API_KEY",
+		},
 	}
 	return builder.Build()
 }
@@ -127,6 +143,9 @@ func resourceRotatedSecretSendGrid() *schema.Resource {
 				"scopes": d.Get("scopes"),
 			}
 		},
+		SecretNameFields: map[string]string{
+			"api_key": "API_KEY",
+		},
 	}
 	return builder.Build()
 }
@@ -183,6 +202,10 @@ func resourceRotatedSecretGCPCloudSQL() *schema.Resource {
 			}
 			return credentials
 		},
+		SecretNameFields: map[string]string{
+			"username": "USERNAME",
+			"password": "PASSWORD",
+		},
 	}
 	return builder.Build()
 }
@@ -201,6 +224,9 @@ func resourceRotatedSecretGCPServiceAccountKeys() *schema.Resource {
 				"serviceAccount": d.Get("service_account"),
 			}
 		},
+		SecretNameFields: map[string]string{
+			"key": "KEY",
+		},
 	}
 	return builder.Build()
 }
@@ -219,6 +245,10 @@ func resourceRotatedSecretAWSIAMUserKeys() *schema.Resource {
 				"username": d.Get("username"),
 			}
 		},
+		SecretNameFields: map[string]string{
+			"access_key_id":     "ACCESS_KEY_ID",
+			"secret_access_key": "SECRET_ACCESS_KEY",
+		},
 	}
 	return builder.Build()
 }
@@ -297,6 +327,10 @@ func resourceRotatedSecretAWSMySQL() *schema.Resource {
 			}
 			return credentials
 		},
+		SecretNameFields: map[string]string{
+			"username": "USERNAME",
+			"password": "PASSWORD",
+		},
 	}
 	return builder.Build()
 }
@@ -381,6 +415,10 @@ func resourceRotatedSecretAWSPostgres() *schema.Resource {
 			}
 			return credentials
 		},
+		SecretNameFields: map[string]string{
+			"username": "USERNAME",
+			"password": "PASSWORD",
+		},
 	}
 	return builder.Build()
 }
@@ -444,6 +482,10 @@ func resourceRotatedSecretAWSMSSQLServer() *schema.Resource {
 			}
 			return credentials
 		},
+		SecretNameFields: map[string]string{
+			"username": "USERNAME",
+			"password": "PASSWORD",
+		},
 	}
 	return builder.Build()
 }
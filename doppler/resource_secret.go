@@ -22,16 +22,18 @@ func resourceSecret() *schema.Resource {
 		DeleteContext: resourceSecretDelete,
 		Schema: map[string]*schema.Schema{
 			"project": {
-				Description: "The name of the Doppler project",
+				Description: "The name of the Doppler project. Defaults to the provider's `default_project`",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				// Secrets cannot be moved directly from one project to another, they must be re-created
 				ForceNew: true,
 			},
 			"config": {
-				Description: "The name of the Doppler config",
+				Description: "The name of the Doppler config. Defaults to the provider's `default_config`",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				// Secrets cannot be moved directly from one config to another, they must be re-created
 				ForceNew: true,
 			},
@@ -71,9 +73,12 @@ func resourceSecret() *schema.Resource {
 				}, false),
 			},
 		},
-		CustomizeDiff: customdiff.ComputedIf("computed", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
-			return d.HasChange("value")
-		}),
+		CustomizeDiff: customdiff.Sequence(
+			resolveDefaultProjectConfig,
+			customdiff.ComputedIf("computed", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("value")
+			}),
+		),
 	}
 }
 
@@ -107,7 +112,7 @@ func resourceSecretUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	// We'll skip the API-level staleness checking to allow Terraform to push over an external change.
 
 	if err := client.UpdateSecrets(ctx, project, config, []ChangeRequest{changeRequest}); err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 
 	d.SetId(getSecretId(project, config, name))
@@ -69,7 +69,7 @@ func resourceServiceAccountCreate(ctx context.Context, d *schema.ResourceData, m
 
 	serviceAccount, err := client.CreateServiceAccount(ctx, name, workplaceRole, workplacePermissions)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 
 	d.SetId(serviceAccount.Slug)
@@ -115,7 +115,7 @@ func resourceServiceAccountUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	serviceAccount, err := client.UpdateServiceAccount(ctx, slug, name, workplaceRole, workplacePermissions)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 
 	err = updateServiceAccountState(d, serviceAccount)
@@ -147,30 +147,31 @@ func resourceServiceAccountRead(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func updateServiceAccountState(d *schema.ResourceData, serviceAccount *ServiceAccount) error {
-	if err := d.Set("slug", serviceAccount.Slug); err != nil {
-		return err
-	}
-
-	if err := d.Set("name", serviceAccount.Name); err != nil {
-		return err
+	for attribute, value := range flattenServiceAccount(serviceAccount) {
+		if err := d.Set(attribute, value); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
+// flattenServiceAccount maps a service account to its attributes. Shared by the resource and both data sources
+// so that `workplace_permissions` is only set for service accounts that don't use a `workplace_role`.
+func flattenServiceAccount(serviceAccount *ServiceAccount) map[string]interface{} {
 	if !serviceAccount.WorkplaceRole.IsInlineRole {
-		if err := d.Set("workplace_role", serviceAccount.WorkplaceRole.Identifier); err != nil {
-			return err
-		}
-		if err := d.Set("workplace_permissions", nil); err != nil {
-			return err
-		}
-	} else {
-		if err := d.Set("workplace_role", ""); err != nil {
-			return err
-		}
-		if err := d.Set("workplace_permissions", serviceAccount.WorkplaceRole.Permissions); err != nil {
-			return err
+		return map[string]interface{}{
+			"slug":                  serviceAccount.Slug,
+			"name":                  serviceAccount.Name,
+			"workplace_role":        serviceAccount.WorkplaceRole.Identifier,
+			"workplace_permissions": nil,
 		}
 	}
-	return nil
+	return map[string]interface{}{
+		"slug":                  serviceAccount.Slug,
+		"name":                  serviceAccount.Name,
+		"workplace_role":        "",
+		"workplace_permissions": serviceAccount.WorkplaceRole.Permissions,
+	}
 }
 
 func resourceServiceAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
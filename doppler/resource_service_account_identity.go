@@ -2,11 +2,14 @@ package doppler
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceServiceAccountIdentity() *schema.Resource {
@@ -40,18 +43,66 @@ func resourceServiceAccountIdentity() *schema.Resource {
 				Type:        schema.TypeInt,
 				Required:    true,
 			},
+			"method": {
+				Description: "The auth method of the identity. Only required alongside `config_json`; it is otherwise derived from the configuration block",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ConflictsWith: []string{
+					"config_oidc", "config_aws_iam", "config_gcp", "config_kubernetes",
+				},
+			},
 			"config_oidc": {
-				Description: "The OIDC configuration for the identity",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				MinItems:    1,
-				Required:    true,
-				Elem:        &resourceServiceAccountIdentityConfigOidc,
+				Description:  "The OIDC configuration for the identity",
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				Elem:         &resourceServiceAccountIdentityConfigOidc,
+				ExactlyOneOf: serviceAccountIdentityConfigAttributes,
+			},
+			"config_aws_iam": {
+				Description:  "The AWS IAM configuration for the identity",
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				Elem:         &resourceServiceAccountIdentityConfigAwsIam,
+				ExactlyOneOf: serviceAccountIdentityConfigAttributes,
+			},
+			"config_gcp": {
+				Description:  "The GCP service account configuration for the identity",
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				Elem:         &resourceServiceAccountIdentityConfigGcp,
+				ExactlyOneOf: serviceAccountIdentityConfigAttributes,
+			},
+			"config_kubernetes": {
+				Description:  "The Kubernetes service account configuration for the identity",
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				Elem:         &resourceServiceAccountIdentityConfigKubernetes,
+				ExactlyOneOf: serviceAccountIdentityConfigAttributes,
+			},
+			"config_json": {
+				Description: "The raw JSON configuration of an identity whose `method` has no dedicated configuration block. " +
+					"Identities with unrecognized auth methods are read into this attribute",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				ExactlyOneOf:     serviceAccountIdentityConfigAttributes,
+				RequiredWith:     []string{"method"},
 			},
 		},
 	}
 }
 
+var serviceAccountIdentityConfigAttributes = []string{
+	"config_oidc", "config_aws_iam", "config_gcp", "config_kubernetes", "config_json",
+}
+
 var resourceServiceAccountIdentityConfigOidc = schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"discovery_url": {
@@ -94,6 +145,85 @@ var resourceServiceAccountIdentityConfigOidcClaims = schema.Resource{
 	},
 }
 
+var resourceServiceAccountIdentityConfigAwsIam = schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"aws_account_id": {
+			Description: "The ID of the AWS account that the authenticating IAM principals belong to",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"allowed_principal_arns": {
+			Description: "The ARNs of the IAM roles and users that may authenticate as this identity",
+			Type:        schema.TypeSet,
+			MinItems:    1,
+			Required:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	},
+}
+
+var resourceServiceAccountIdentityConfigGcp = schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"allowed_service_account_emails": {
+			Description: "The emails of the GCP service accounts that may authenticate as this identity",
+			Type:        schema.TypeSet,
+			MinItems:    1,
+			Required:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"allowed_project_ids": {
+			Description: "The GCP projects that the authenticating service accounts must belong to. If not provided, any project is allowed",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	},
+}
+
+var resourceServiceAccountIdentityConfigKubernetes = schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"kubernetes_host": {
+			Description: "The URL of the Kubernetes API server used to review service account tokens",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"ca_cert": {
+			Description: "The PEM-encoded CA certificate of the Kubernetes API server",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"audience": {
+			Description: "The audience that service account tokens must be issued for",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"allowed_namespaces": {
+			Description: "The namespaces of the Kubernetes service accounts that may authenticate as this identity",
+			Type:        schema.TypeSet,
+			MinItems:    1,
+			Required:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"allowed_service_accounts": {
+			Description: "The names of the Kubernetes service accounts that may authenticate as this identity",
+			Type:        schema.TypeSet,
+			MinItems:    1,
+			Required:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	},
+}
+
 func resourceServiceAccountIdentityImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	split := strings.Split(d.Id(), `.`)
 	if len(split) != 2 {
@@ -120,7 +250,7 @@ func resourceServiceAccountIdentityCreate(ctx context.Context, d *schema.Resourc
 
 	id, err := client.CreateServiceAccountIdentity(ctx, serviceAccountSlug, &payload)
 	if err != nil {
-		diags = append(diags, diag.FromErr(err)...)
+		diags = append(diags, apiErrorDiagnostics(err, d, nil)...)
 		return diags
 	}
 
@@ -157,7 +287,7 @@ func resourceServiceAccountIdentityUpdate(ctx context.Context, d *schema.Resourc
 
 	id, err := client.UpdateServiceAccountIdentity(ctx, serviceAccountSlug, &payload)
 	if err != nil {
-		diags = append(diags, diag.FromErr(err)...)
+		diags = append(diags, apiErrorDiagnostics(err, d, nil)...)
 		return diags
 	}
 
@@ -207,6 +337,45 @@ func toServiceAccountIdentity(d *schema.ResourceData, diags diag.Diagnostics) (S
 		}
 	}
 
+	if awsIamConfigList, ok := d.GetOk("config_aws_iam"); ok {
+		id.Method = "aws_iam"
+		awsIamConfig := awsIamConfigList.([]interface{})[0].(map[string]interface{})
+		id.ConfigAwsIam = ServiceAccountIdentityConfigAwsIam{
+			AwsAccountId:         awsIamConfig["aws_account_id"].(string),
+			AllowedPrincipalArns: stringSetToSlice(awsIamConfig["allowed_principal_arns"].(*schema.Set)),
+		}
+	}
+
+	if gcpConfigList, ok := d.GetOk("config_gcp"); ok {
+		id.Method = "gcp"
+		gcpConfig := gcpConfigList.([]interface{})[0].(map[string]interface{})
+		id.ConfigGcp = ServiceAccountIdentityConfigGcp{
+			AllowedServiceAccountEmails: stringSetToSlice(gcpConfig["allowed_service_account_emails"].(*schema.Set)),
+			AllowedProjectIds:           stringSetToSlice(gcpConfig["allowed_project_ids"].(*schema.Set)),
+		}
+	}
+
+	if kubernetesConfigList, ok := d.GetOk("config_kubernetes"); ok {
+		id.Method = "kubernetes"
+		kubernetesConfig := kubernetesConfigList.([]interface{})[0].(map[string]interface{})
+		id.ConfigKubernetes = ServiceAccountIdentityConfigKubernetes{
+			KubernetesHost:         kubernetesConfig["kubernetes_host"].(string),
+			CaCert:                 kubernetesConfig["ca_cert"].(string),
+			Audience:               kubernetesConfig["audience"].(string),
+			AllowedNamespaces:      stringSetToSlice(kubernetesConfig["allowed_namespaces"].(*schema.Set)),
+			AllowedServiceAccounts: stringSetToSlice(kubernetesConfig["allowed_service_accounts"].(*schema.Set)),
+		}
+	}
+
+	if id.Method == "" {
+		// Auth methods without a configuration block are passed through as raw JSON
+		id.Method = d.Get("method").(string)
+		id.Config = json.RawMessage(d.Get("config_json").(string))
+		if isKnownServiceAccountIdentityMethod(id.Method) {
+			diags = append(diags, diag.Errorf("The %q auth method must be configured with its `config_%s` block instead of `config_json`", id.Method, id.Method)...)
+		}
+	}
+
 	return id, diags
 }
 
@@ -227,6 +396,10 @@ func updateServiceAccountIdentityState(d *schema.ResourceData, serviceAccountSlu
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
+	if err := d.Set("method", id.Method); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
 	switch id.Method {
 	case "oidc":
 		claimSet := schema.NewSet(schema.HashResource(&resourceServiceAccountIdentityConfigOidcClaims), make([]interface{}, 0))
@@ -254,10 +427,60 @@ func updateServiceAccountIdentityState(d *schema.ResourceData, serviceAccountSlu
 		if err := d.Set("config_oidc", configOidcList); err != nil {
 			diags = append(diags, diag.FromErr(err)...)
 		}
-	default:
-		diags = append(diags, diag.FromErr(errors.New("Unknown auth method type"))...)
+	case "aws_iam":
+		configAwsIam := map[string]interface{}{
+			"aws_account_id":         id.ConfigAwsIam.AwsAccountId,
+			"allowed_principal_arns": id.ConfigAwsIam.AllowedPrincipalArns,
+		}
+		if err := d.Set("config_aws_iam", []interface{}{configAwsIam}); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	case "gcp":
+		configGcp := map[string]interface{}{
+			"allowed_service_account_emails": id.ConfigGcp.AllowedServiceAccountEmails,
+			"allowed_project_ids":            id.ConfigGcp.AllowedProjectIds,
+		}
+		if err := d.Set("config_gcp", []interface{}{configGcp}); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	case "kubernetes":
+		configKubernetes := map[string]interface{}{
+			"kubernetes_host":          id.ConfigKubernetes.KubernetesHost,
+			"ca_cert":                  id.ConfigKubernetes.CaCert,
+			"audience":                 id.ConfigKubernetes.Audience,
+			"allowed_namespaces":       id.ConfigKubernetes.AllowedNamespaces,
+			"allowed_service_accounts": id.ConfigKubernetes.AllowedServiceAccounts,
+		}
+		if err := d.Set("config_kubernetes", []interface{}{configKubernetes}); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	// The raw configuration is only tracked for auth methods without a configuration block
+	configJson := ""
+	if !isKnownServiceAccountIdentityMethod(id.Method) {
+		configJson = string(id.Config)
+	}
+	if err := d.Set("config_json", configJson); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
 	}
 
 	d.SetId(id.Slug)
 	return diags
 }
+
+func isKnownServiceAccountIdentityMethod(method string) bool {
+	switch method {
+	case "oidc", "aws_iam", "gcp", "kubernetes":
+		return true
+	}
+	return false
+}
+
+func stringSetToSlice(set *schema.Set) []string {
+	values := make([]string, set.Len())
+	for i, v := range set.List() {
+		values[i] = v.(string)
+	}
+	return values
+}
@@ -67,7 +67,7 @@ func resourceServiceAccountTokenCreate(ctx context.Context, d *schema.ResourceDa
 
 	token, err := client.CreateServiceAccountToken(ctx, serviceAccount, name, expiresAt)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 
 	d.SetId(token.ServiceAccountToken.getResourceId())
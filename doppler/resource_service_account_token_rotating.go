@@ -0,0 +1,309 @@
+package doppler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The computed attributes that change when the token is rotated
+var serviceAccountTokenRotatingComputedFields = []string{
+	"current_slug", "current_api_key", "current_created_at", "current_expires_at",
+	"previous_slug", "previous_api_key", "previous_expires_at",
+	"rotates_at",
+}
+
+func resourceServiceAccountTokenRotating() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceAccountTokenRotatingCreate,
+		ReadContext:   resourceServiceAccountTokenRotatingRead,
+		UpdateContext: resourceServiceAccountTokenRotatingUpdate,
+		DeleteContext: resourceServiceAccountTokenRotatingDelete,
+		CustomizeDiff: resourceServiceAccountTokenRotatingCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"service_account_slug": {
+				Description: "Slug of the service account",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The display name prefix of the API tokens. Each token is named with this prefix and its creation time",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"rotation_days": {
+				Description:  "How many days each token is the current token before it is rotated",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"overlap_days": {
+				Description:  "How many days the previous token remains valid after it is rotated. Defaults to 1",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"rotates_at": {
+				Description: "The datetime after which the next plan rotates the token",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"current_slug": {
+				Description: "Slug of the current service account token",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"current_api_key": {
+				Description: "The api key of the current token, used to authenticate the service account",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"current_created_at": {
+				Description: "The datetime that the current token was created",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"current_expires_at": {
+				Description: "The datetime at which the current token expires",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"previous_slug": {
+				Description: "Slug of the previous service account token, if it is still within its overlap window",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"previous_api_key": {
+				Description: "The api key of the previous token, if it is still within its overlap window",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"previous_expires_at": {
+				Description: "The datetime at which the previous token expires",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceServiceAccountTokenRotatingCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	rotatesAt, err := serviceAccountTokenRotatesAt(d.Get("current_expires_at").(string), d.Get("overlap_days").(int))
+	if err != nil {
+		return err
+	}
+
+	if !serviceAccountTokenDueForRotation(rotatesAt, time.Now().UTC()) {
+		// Changing overlap_days moves the rotation time of the current token
+		if d.HasChange("overlap_days") {
+			return d.SetNew("rotates_at", rotatesAt.Format(time.RFC3339))
+		}
+		return nil
+	}
+
+	for _, field := range serviceAccountTokenRotatingComputedFields {
+		if err := d.SetNewComputed(field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceServiceAccountTokenRotatingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	serviceAccount := d.Get("service_account_slug").(string)
+	name := d.Get("name").(string)
+
+	token, err := createServiceAccountTokenRotation(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Join([]string{serviceAccount, name}, "."))
+
+	if err = updateServiceAccountTokenRotatingState(d, token, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceServiceAccountTokenRotatingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	serviceAccount := d.Get("service_account_slug").(string)
+
+	// The computed fields may be unknown during apply, so the rotation is evaluated against the prior state
+	currentExpiresAt, _ := d.GetChange("current_expires_at")
+	rotatesAt, err := serviceAccountTokenRotatesAt(currentExpiresAt.(string), d.Get("overlap_days").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !serviceAccountTokenDueForRotation(rotatesAt, time.Now().UTC()) {
+		if err = d.Set("rotates_at", rotatesAt.Format(time.RFC3339)); err != nil {
+			return diag.FromErr(err)
+		}
+		return diags
+	}
+
+	previousSlug, _ := d.GetChange("previous_slug")
+	currentSlug, _ := d.GetChange("current_slug")
+	currentApiKey, _ := d.GetChange("current_api_key")
+
+	token, err := createServiceAccountTokenRotation(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	previous := &ServiceAccountTokenResponse{
+		ServiceAccountToken: ServiceAccountToken{
+			Slug:      currentSlug.(string),
+			ExpiresAt: currentExpiresAt.(string),
+		},
+		ApiKey: currentApiKey.(string),
+	}
+	if err = updateServiceAccountTokenRotatingState(d, token, previous); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The old previous token is revoked now that the old current token takes its place.
+	// The new token is already in state, so failing to revoke the old one only warns rather than losing the new token.
+	if previousSlug.(string) != "" {
+		if err := client.DeleteServiceAccountToken(ctx, serviceAccount, previousSlug.(string)); err != nil && !isNotFoundError(err) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unable to revoke the previous service account token",
+				Detail:   fmt.Sprintf("The token was rotated, but the token %s it replaced couldn't be revoked and will remain valid until it expires unless it is revoked in Doppler: %s", previousSlug.(string), err),
+			})
+		}
+	}
+
+	return diags
+}
+
+func resourceServiceAccountTokenRotatingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	serviceAccount := d.Get("service_account_slug").(string)
+
+	current, err := client.GetServiceAccountToken(ctx, serviceAccount, d.Get("current_slug").(string))
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+	// API keys are only returned when the token is created
+	current.ApiKey = d.Get("current_api_key").(string)
+
+	var previous *ServiceAccountTokenResponse
+	if previousSlug := d.Get("previous_slug").(string); previousSlug != "" {
+		token, err := client.GetServiceAccountToken(ctx, serviceAccount, previousSlug)
+		if err != nil && !isNotFoundError(err) {
+			return diag.FromErr(err)
+		}
+		// The previous token is dropped from state once it has expired
+		if err == nil && !serviceAccountTokenExpired(token.ServiceAccountToken.ExpiresAt, time.Now().UTC()) {
+			token.ApiKey = d.Get("previous_api_key").(string)
+			previous = &token
+		}
+	}
+
+	if err = updateServiceAccountTokenRotatingState(d, &current, previous); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceServiceAccountTokenRotatingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	serviceAccount := d.Get("service_account_slug").(string)
+
+	for _, field := range []string{"previous_slug", "current_slug"} {
+		slug := d.Get(field).(string)
+		if slug == "" {
+			continue
+		}
+		if err := client.DeleteServiceAccountToken(ctx, serviceAccount, slug); err != nil && !isNotFoundError(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+// createServiceAccountTokenRotation creates a token that is valid for the rotation period plus the overlap window.
+func createServiceAccountTokenRotation(ctx context.Context, client APIClient, d *schema.ResourceData) (*ServiceAccountTokenResponse, error) {
+	serviceAccount := d.Get("service_account_slug").(string)
+	now := time.Now().UTC()
+	name := fmt.Sprintf("%s (%s)", d.Get("name").(string), now.Format(time.RFC3339))
+	expiresAt := now.AddDate(0, 0, d.Get("rotation_days").(int)+d.Get("overlap_days").(int)).Format(time.RFC3339)
+
+	return client.CreateServiceAccountToken(ctx, serviceAccount, name, expiresAt)
+}
+
+// serviceAccountTokenRotatesAt returns when a token expiring at `expiresAt` enters its overlap window and should be rotated.
+func serviceAccountTokenRotatesAt(expiresAt string, overlapDays int) (time.Time, error) {
+	expires, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Unable to parse token expiration %q: %w", expiresAt, err)
+	}
+	return expires.AddDate(0, 0, -overlapDays), nil
+}
+
+// serviceAccountTokenDueForRotation reports whether a token rotating at `rotatesAt` should be rotated at `now`.
+func serviceAccountTokenDueForRotation(rotatesAt time.Time, now time.Time) bool {
+	return !now.Before(rotatesAt)
+}
+
+func serviceAccountTokenExpired(expiresAt string, now time.Time) bool {
+	expires, err := time.Parse(time.RFC3339, expiresAt)
+	return err == nil && !now.Before(expires)
+}
+
+func updateServiceAccountTokenRotatingState(d *schema.ResourceData, current *ServiceAccountTokenResponse, previous *ServiceAccountTokenResponse) error {
+	rotatesAt, err := serviceAccountTokenRotatesAt(current.ServiceAccountToken.ExpiresAt, d.Get("overlap_days").(int))
+	if err != nil {
+		return err
+	}
+
+	if previous == nil {
+		previous = &ServiceAccountTokenResponse{}
+	}
+
+	values := map[string]string{
+		"rotates_at":          rotatesAt.Format(time.RFC3339),
+		"current_slug":        current.ServiceAccountToken.Slug,
+		"current_api_key":     current.ApiKey,
+		"current_created_at":  current.ServiceAccountToken.CreatedAt,
+		"current_expires_at":  current.ServiceAccountToken.ExpiresAt,
+		"previous_slug":       previous.ServiceAccountToken.Slug,
+		"previous_api_key":    previous.ApiKey,
+		"previous_expires_at": previous.ServiceAccountToken.ExpiresAt,
+	}
+	for _, field := range serviceAccountTokenRotatingComputedFields {
+		if err := d.Set(field, values[field]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package doppler
+
+import (
+	"testing"
+	"time"
+)
+
+func TestServiceAccountTokenRotatesAt(t *testing.T) {
+	tests := []struct {
+		name        string
+		expiresAt   string
+		overlapDays int
+		want        string
+		wantErr     bool
+	}{
+		{name: "no overlap", expiresAt: "2024-03-10T12:00:00Z", overlapDays: 0, want: "2024-03-10T12:00:00Z"},
+		{name: "overlap", expiresAt: "2024-03-10T12:00:00Z", overlapDays: 3, want: "2024-03-07T12:00:00Z"},
+		{name: "overlap across months", expiresAt: "2024-03-01T00:00:00Z", overlapDays: 1, want: "2024-02-29T00:00:00Z"},
+		{name: "offset", expiresAt: "2024-03-10T12:00:00+02:00", overlapDays: 1, want: "2024-03-09T10:00:00Z"},
+		{name: "empty", expiresAt: "", overlapDays: 1, wantErr: true},
+		{name: "invalid", expiresAt: "2024-03-10", overlapDays: 1, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rotatesAt, err := serviceAccountTokenRotatesAt(test.expiresAt, test.overlapDays)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", rotatesAt)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got := rotatesAt.UTC().Format(time.RFC3339); got != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestServiceAccountTokenExpired(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		expiresAt string
+		want      bool
+	}{
+		{name: "future", expiresAt: "2024-03-10T12:00:01Z", want: false},
+		{name: "exactly now", expiresAt: "2024-03-10T12:00:00Z", want: true},
+		{name: "past", expiresAt: "2024-03-10T11:59:59Z", want: true},
+		{name: "invalid", expiresAt: "never", want: false},
+		{name: "empty", expiresAt: "", want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := serviceAccountTokenExpired(test.expiresAt, now); got != test.want {
+				t.Errorf("got %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestServiceAccountTokenOverlapWindow(t *testing.T) {
+	expiresAt := "2024-03-10T12:00:00Z"
+	rotatesAt, err := serviceAccountTokenRotatesAt(expiresAt, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tests := []struct {
+		name        string
+		now         time.Time
+		wantRotate  bool
+		wantExpired bool
+	}{
+		{name: "before the overlap window", now: rotatesAt.Add(-time.Second), wantRotate: false, wantExpired: false},
+		{name: "start of the overlap window", now: rotatesAt, wantRotate: true, wantExpired: false},
+		{name: "within the overlap window", now: rotatesAt.AddDate(0, 0, 1), wantRotate: true, wantExpired: false},
+		{name: "end of the overlap window", now: rotatesAt.AddDate(0, 0, 2), wantRotate: true, wantExpired: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := serviceAccountTokenDueForRotation(rotatesAt, test.now); got != test.wantRotate {
+				t.Errorf("due for rotation: got %t, want %t", got, test.wantRotate)
+			}
+			if got := serviceAccountTokenExpired(expiresAt, test.now); got != test.wantExpired {
+				t.Errorf("expired: got %t, want %t", got, test.wantExpired)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package doppler
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,19 +15,25 @@ func resourceServiceToken() *schema.Resource {
 		CreateContext: resourceServiceTokenCreate,
 		ReadContext:   resourceServiceTokenRead,
 		DeleteContext: resourceServiceTokenDelete,
+		CustomizeDiff: resolveDefaultProjectConfig,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServiceTokenImport,
+		},
 		// ForceNew is specified for all user-specified fields
 		// Service tokens cannot be moved, renamed, or edited to change their access
 		Schema: map[string]*schema.Schema{
 			"project": {
-				Description: "The name of the Doppler project where the service token is located",
+				Description: "The name of the Doppler project where the service token is located. Defaults to the provider's `default_project`",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
 			"config": {
-				Description: "The name of the Doppler config where the service token is located",
+				Description: "The name of the Doppler config where the service token is located. Defaults to the provider's `default_config`",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
 			"name": {
@@ -42,6 +50,15 @@ func resourceServiceToken() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"read", "read/write"}, false),
 				ForceNew:     true,
 			},
+			"expire_at": {
+				Description: "The datetime (RFC 3339) at which the service token expires. " +
+					"If not provided, the service token will remain valid indefinitely unless manually revoked",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTimeDiff,
+			},
 			"key": {
 				Description: "The key for the Doppler service token",
 				Type:        schema.TypeString,
@@ -60,21 +77,40 @@ func resourceServiceTokenCreate(ctx context.Context, d *schema.ResourceData, m i
 	config := d.Get("config").(string)
 	access := d.Get("access").(string)
 	name := d.Get("name").(string)
+	expireAt := d.Get("expire_at").(string)
 
-	token, err := client.CreateServiceToken(ctx, project, config, access, name)
+	token, err := client.CreateServiceToken(ctx, project, config, access, name, expireAt)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 
 	d.SetId(token.getResourceId())
 
-	if err = d.Set("key", token.Key); err != nil {
-		return diag.FromErr(err)
+	// `key` is left unset rather than empty if the API didn't return it
+	if token.Key != "" {
+		if err = d.Set("key", token.Key); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return diags
 }
 
+func resourceServiceTokenImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	project, config, _, err := parseServiceTokenResourceId(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{d}, errors.New("Service token id not in the format <project-name>.<config-name>.<service-token-slug>")
+	}
+	if err := d.Set("project", project); err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	if err := d.Set("config", config); err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceServiceTokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(APIClient)
 
@@ -102,6 +138,9 @@ func resourceServiceTokenRead(ctx context.Context, d *schema.ResourceData, m int
 		return handleNotFoundError(err, d)
 	}
 
+	// Importers can't return diagnostics, so the read that follows an import (before `name` is known) warns about the key instead
+	imported := d.Get("name").(string) == ""
+
 	if err = d.Set("project", token.Project); err != nil {
 		return diag.FromErr(err)
 	}
@@ -110,15 +149,43 @@ func resourceServiceTokenRead(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
+	if err = d.Set("name", token.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err = d.Set("access", token.Access); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err = d.Set("expire_at", token.ExpiresAt); err != nil {
+		return diag.FromErr(err)
+	}
+
 	// `key` cannot be read after initial creation
+	if imported {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Service token key is unavailable",
+			Detail:   "The key of a service token can only be read when the token is created, so `key` is not set for imported service tokens. Recreate the service token if its key is needed.",
+		})
+	}
 
 	return diags
 }
 
+// suppressEquivalentTimeDiff ignores differences in the formatting of RFC 3339 datetimes (e.g. fractional seconds).
+func suppressEquivalentTimeDiff(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, oldValue)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, newValue)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
 func resourceServiceTokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(APIClient)
 
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -26,15 +27,17 @@ func (builder ResourceSyncBuilder) Build() *schema.Resource {
 			ForceNew:    true,
 		},
 		"project": {
-			Description: "The name of the Doppler project",
+			Description: "The name of the Doppler project. Defaults to the provider's `default_project`",
 			Type:        schema.TypeString,
-			Required:    true,
+			Optional:    true,
+			Computed:    true,
 			ForceNew:    true,
 		},
 		"config": {
-			Description: "The name of the Doppler config",
+			Description: "The name of the Doppler config. Defaults to the provider's `default_config`",
 			Type:        schema.TypeString,
-			Required:    true,
+			Optional:    true,
+			Computed:    true,
 			ForceNew:    true,
 		},
 		"delete_behavior": {
@@ -51,14 +54,20 @@ func (builder ResourceSyncBuilder) Build() *schema.Resource {
 		resourceSchema[name] = &s
 	}
 
-	return &schema.Resource{
+	customizeDiff := resolveDefaultProjectConfig
+	if builder.CustomizeDiff != nil {
+		customizeDiff = customdiff.Sequence(resolveDefaultProjectConfig, builder.CustomizeDiff)
+	}
+
+	return withSlowRequests(&schema.Resource{
 		CreateContext: builder.CreateContextFunc(),
 		ReadContext:   builder.ReadContextFunc(),
 		UpdateContext: resourceSyncUpdate,
 		DeleteContext: builder.DeleteContextFunc(),
 		Schema:        resourceSchema,
-		CustomizeDiff: builder.CustomizeDiff,
-	}
+		CustomizeDiff: customizeDiff,
+		Timeouts:      slowResourceTimeouts(),
+	})
 }
 
 func (builder ResourceSyncBuilder) CreateContextFunc() schema.CreateContextFunc {
@@ -73,7 +82,7 @@ func (builder ResourceSyncBuilder) CreateContextFunc() schema.CreateContextFunc
 
 		sync, err := client.CreateSync(ctx, syncData, config, project, integ)
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err, d, nil)
 		}
 
 		d.SetId(sync.Slug)
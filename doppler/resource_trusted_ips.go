@@ -17,26 +17,34 @@ func validateCIDR(val interface{}, key string) (warns []string, errs []error) {
 	return
 }
 
+// Each trusted IP is added and removed individually, as the API's `ip` parameter
+var trustedIPsFieldAttributes = apiFieldAttributes{
+	"ip": "trusted_ips",
+}
+
 func resourceTrustedIPs() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceTrustedIPsCreate,
 		ReadContext:   resourceTrustedIPsRead,
 		UpdateContext: resourceTrustedIPsUpdate,
 		DeleteContext: resourceTrustedIPsDelete,
+		CustomizeDiff: resolveDefaultProjectConfig,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"project": {
-				Description: "The name of the Doppler project where the config is located",
+				Description: "The name of the Doppler project where the config is located. Defaults to the provider's `default_project`",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
 			"config": {
-				Description: "The name of the Doppler config",
+				Description: "The name of the Doppler config. Defaults to the provider's `default_config`",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
 			"trusted_ips": {
@@ -61,7 +69,7 @@ func resourceTrustedIPsCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	currentIPs, err := client.GetTrustedIPs(ctx, project, config)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, trustedIPsFieldAttributes)
 	}
 
 	isDefaultOnly := len(currentIPs) == 1 && currentIPs[0] == "0.0.0.0/0"
@@ -89,7 +97,7 @@ func resourceTrustedIPsCreate(ctx context.Context, d *schema.ResourceData, m int
 	for ip := range desiredIPMap {
 		if !currentIPMap[ip] {
 			if err := client.AddTrustedIP(ctx, project, config, ip); err != nil {
-				return append(diags, diag.FromErr(err)...)
+				return append(diags, apiErrorDiagnostics(err, d, trustedIPsFieldAttributes)...)
 			}
 		}
 	}
@@ -97,7 +105,7 @@ func resourceTrustedIPsCreate(ctx context.Context, d *schema.ResourceData, m int
 	for ip := range currentIPMap {
 		if !desiredIPMap[ip] {
 			if err := client.DeleteTrustedIP(ctx, project, config, ip); err != nil {
-				return append(diags, diag.FromErr(err)...)
+				return append(diags, apiErrorDiagnostics(err, d, trustedIPsFieldAttributes)...)
 			}
 		}
 	}
@@ -158,7 +166,7 @@ func resourceTrustedIPsUpdate(ctx context.Context, d *schema.ResourceData, m int
 		for ip := range newIPMap {
 			if !oldIPMap[ip] {
 				if err := client.AddTrustedIP(ctx, project, config, ip); err != nil {
-					return diag.FromErr(err)
+					return apiErrorDiagnostics(err, d, trustedIPsFieldAttributes)
 				}
 			}
 		}
@@ -166,7 +174,7 @@ func resourceTrustedIPsUpdate(ctx context.Context, d *schema.ResourceData, m int
 		for ip := range oldIPMap {
 			if !newIPMap[ip] {
 				if err := client.DeleteTrustedIP(ctx, project, config, ip); err != nil {
-					return diag.FromErr(err)
+					return apiErrorDiagnostics(err, d, trustedIPsFieldAttributes)
 				}
 			}
 		}
@@ -2,18 +2,36 @@ package doppler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// The webhook parameters that are named differently from their attributes
+var webhookFieldAttributes = apiFieldAttributes{
+	"enableConfigs":           "enabled_configs",
+	"disableConfigs":          "enabled_configs",
+	"authentication.type":     "authentication.0.type",
+	"authentication.token":    "authentication.0.token",
+	"authentication.username": "authentication.0.username",
+	"authentication.password": "authentication.0.password",
+}
+
 func resourceWebhook() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceWebhookCreate,
 		ReadContext:   resourceWebhookRead,
 		UpdateContext: resourceWebhookUpdate,
 		DeleteContext: resourceWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWebhookImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"slug": {
 				Description: "The slug of the Webhook",
@@ -77,10 +95,11 @@ func resourceWebhook() *schema.Resource {
 				Optional: true,
 			},
 			"payload": {
-				Description: "The webhook's payload as a JSON string.  Leave empty to use the default webhook payload",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
+				Description:      "The webhook's payload as a JSON string.  Leave empty to use the default webhook payload",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
 			},
 			"enabled_configs": {
 				Description: "Configs this webhook will trigger for",
@@ -90,10 +109,32 @@ func resourceWebhook() *schema.Resource {
 				},
 				Optional: true,
 			},
+			"events": {
+				Description: fmt.Sprintf("The event types that trigger the webhook. The webhook is triggered by all events when this is empty or omitted. Valid events: %v", strings.Join(WebhookEvents, ", ")),
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(WebhookEvents, false),
+				},
+				Optional: true,
+			},
 		},
 	}
 }
 
+func resourceWebhookImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	split := strings.Split(d.Id(), `.`)
+	if len(split) != 2 {
+		return []*schema.ResourceData{d}, errors.New("Webhook id not in the format <project-name>.<webhook-slug>")
+	}
+	if err := d.Set("project", split[0]); err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	d.SetId(split[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(APIClient)
 
@@ -113,6 +154,10 @@ func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	options := CreateWebhookOptionalParameters{Secret: secret, WebhookPayload: payload, EnabledConfigs: enabledConfigs, Name: name}
 
+	if rawEvents, ok := d.GetOk("events"); ok {
+		options.Events = webhookEvents(rawEvents.(*schema.Set))
+	}
+
 	authConfigList := d.Get("authentication").([]interface{})
 
 	if len(authConfigList) > 0 {
@@ -131,7 +176,7 @@ func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	webhook, err := client.CreateWebhook(ctx, project, url, enabled, &options)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, webhookFieldAttributes)
 	}
 
 	d.SetId(webhook.Slug)
@@ -145,28 +190,50 @@ func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	var diags diag.Diagnostics
 	slug := d.Id()
 	project := d.Get("project").(string)
-	secret := d.Get("secret").(string)
-	payload := d.Get("payload").(string)
-	name := d.Get("name").(string)
 
 	if d.HasChange("enabled") {
 		if d.Get("enabled").(bool) {
 			_, err := client.EnableWebhook(ctx, project, slug)
 			if err != nil {
-				return diag.FromErr(err)
+				return apiErrorDiagnostics(err, d, webhookFieldAttributes)
 			}
 		} else {
 			_, err := client.DisableWebhook(ctx, project, slug)
 			if err != nil {
-				return diag.FromErr(err)
+				return apiErrorDiagnostics(err, d, webhookFieldAttributes)
 			}
 		}
 	}
 
-	url := d.Get("url").(string)
-	enabledConfigs := []string{}
-	disabledConfigs := []string{}
+	if !d.HasChangesExcept("enabled") {
+		return diags
+	}
+
+	options := UpdateWebhookOptionalParameters{}
+
+	if d.HasChange("url") {
+		url := d.Get("url").(string)
+		options.Url = &url
+	}
+
+	if d.HasChange("secret") {
+		secret := d.Get("secret").(string)
+		options.Secret = &secret
+	}
+
+	if d.HasChange("payload") {
+		payload := d.Get("payload").(string)
+		options.WebhookPayload = &payload
+	}
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		options.Name = &name
+	}
+
 	if d.HasChange("enabled_configs") {
+		enabledConfigs := []string{}
+		disabledConfigs := []string{}
 		oldRawEnabledConfigs, newRawEnabledConfigs := d.GetChange("enabled_configs")
 		oldRawEnabledConfigsArr := oldRawEnabledConfigs.(*schema.Set).List()
 		newRawEnabledConfigsArr := newRawEnabledConfigs.(*schema.Set).List()
@@ -190,31 +257,41 @@ func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interf
 				disabledConfigs = append(disabledConfigs, v.(string))
 			}
 		}
+
+		options.EnabledConfigs = enabledConfigs
+		options.DisabledConfigs = disabledConfigs
 	}
 
-	authConfigList := d.Get("authentication").([]interface{})
-	var auth WebhookAuth
+	if d.HasChange("events") {
+		options.Events = webhookEvents(d.Get("events").(*schema.Set))
+	}
 
-	if len(authConfigList) > 0 {
-		authMap, ok := authConfigList[0].(map[string]interface{}) // schema allows only 1 item
-		if !ok {
-			return diag.FromErr(fmt.Errorf("unexpected type for authentication element: %T", authConfigList))
-		}
+	if d.HasChange("authentication") {
+		authConfigList := d.Get("authentication").([]interface{})
+		var auth WebhookAuth
 
-		auth = WebhookAuth{
-			Type:     authMap["type"].(string),
-			Token:    authMap["token"].(string),
-			Username: authMap["username"].(string),
-			Password: authMap["password"].(string),
+		if len(authConfigList) > 0 {
+			authMap, ok := authConfigList[0].(map[string]interface{}) // schema allows only 1 item
+			if !ok {
+				return diag.FromErr(fmt.Errorf("unexpected type for authentication element: %T", authConfigList))
+			}
+
+			auth = WebhookAuth{
+				Type:     authMap["type"].(string),
+				Token:    authMap["token"].(string),
+				Username: authMap["username"].(string),
+				Password: authMap["password"].(string),
+			}
+		} else {
+			auth = WebhookAuth{Type: "None"}
 		}
-	} else {
-		auth = WebhookAuth{Type: "None"}
+		options.Auth = &auth
 	}
 
-	webhook, err := client.UpdateWebhook(ctx, project, slug, url, secret, payload, name, enabledConfigs, disabledConfigs, auth)
+	webhook, err := client.UpdateWebhook(ctx, project, slug, &options)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, webhookFieldAttributes)
 	}
 	d.SetId(webhook.Slug)
 	return diags
@@ -252,9 +329,72 @@ func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.FromErr(err)
 	}
 
+	if err = d.Set("events", flattenWebhookEvents(webhook.Events, d.Get("events").(*schema.Set))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("payload", webhook.Payload); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The API only reports whether a secret is set, so the configured secret is kept unless it was removed out-of-band
+	if !webhook.HasSecret {
+		if err = d.Set("secret", ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err = d.Set("authentication", flattenWebhookAuth(d, webhook.Authentication)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
+// flattenWebhookAuth reconciles the webhook's authentication type and username with state.
+// The token and password aren't returned by the API, so they're carried over from the existing state.
+func flattenWebhookAuth(d *schema.ResourceData, auth *WebhookAuth) []interface{} {
+	if auth == nil || auth.Type == "" || auth.Type == "None" {
+		return []interface{}{}
+	}
+
+	authMap := map[string]interface{}{
+		"type":     auth.Type,
+		"username": auth.Username,
+		"token":    "",
+		"password": "",
+	}
+	if authConfigList := d.Get("authentication").([]interface{}); len(authConfigList) > 0 {
+		if stateAuthMap, ok := authConfigList[0].(map[string]interface{}); ok && stateAuthMap["type"] == auth.Type {
+			authMap["token"] = stateAuthMap["token"]
+			authMap["password"] = stateAuthMap["password"]
+		}
+	}
+	return []interface{}{authMap}
+}
+
+func webhookEvents(rawEvents *schema.Set) []string {
+	events := make([]string, rawEvents.Len())
+	for i, v := range rawEvents.List() {
+		events[i] = v.(string)
+	}
+	return events
+}
+
+// flattenWebhookEvents normalises a webhook triggered by every event to an empty set, which is how that's configured.
+// The events are kept as they are when they were already listed in state, i.e. when all of them were configured explicitly.
+func flattenWebhookEvents(events []string, stateEvents *schema.Set) []string {
+	if stateEvents.Len() > 0 || len(events) == 0 {
+		return events
+	}
+	for _, event := range WebhookEvents {
+		if !slices.Contains(events, event) {
+			return events
+		}
+	}
+	return []string{}
+}
+
 func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(APIClient)
 
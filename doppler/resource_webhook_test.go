@@ -0,0 +1,32 @@
+package doppler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFlattenWebhookEvents(t *testing.T) {
+	allEvents := append([]string{}, WebhookEvents...)
+
+	tests := []struct {
+		name        string
+		events      []string
+		stateEvents []interface{}
+		want        []string
+	}{
+		{"all events", allEvents, nil, []string{}},
+		{"all events configured explicitly", allEvents, []interface{}{"secrets.update"}, allEvents},
+		{"some events", []string{"secrets.update", "sync.failed"}, nil, []string{"secrets.update", "sync.failed"}},
+		{"no events", nil, nil, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stateEvents := schema.NewSet(schema.HashString, test.stateEvents)
+			if got := flattenWebhookEvents(test.events, stateEvents); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -78,7 +78,7 @@ func resourceWorkplaceRoleCreate(ctx context.Context, d *schema.ResourceData, m
 
 	role, err := client.CreateWorkplaceRole(ctx, name, permissions)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 
 	diags = append(diags, updateWorkplaceRoleData(d, role)...)
@@ -98,7 +98,7 @@ func resourceWorkplaceRoleUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	role, err := client.UpdateWorkplaceRole(ctx, identifier, newName, newPermissions)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err, d, nil)
 	}
 	diags = append(diags, updateWorkplaceRoleData(d, role)...)
 	return diags
@@ -0,0 +1,170 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The workplace settings managed by this resource. Attribute names match the API's field names.
+var workplaceSettingsFields = []string{"name", "billing_email", "security_email", "enforce_sso", "require_2fa", "default_secret_visibility"}
+
+func resourceWorkplaceSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkplaceSettingsCreate,
+		ReadContext:   resourceWorkplaceSettingsRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		UpdateContext: resourceWorkplaceSettingsUpdate,
+		DeleteContext: resourceWorkplaceSettingsDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the workplace",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"billing_email": {
+				Description: "The email address that receives billing notifications",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"security_email": {
+				Description: "The email address that receives security notifications",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"enforce_sso": {
+				Description: "Whether workplace members must sign in with SSO",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"require_2fa": {
+				Description: "Whether workplace members must have two-factor authentication enabled",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"default_secret_visibility": {
+				Description:  "The visibility assigned to new secrets when none is specified. Valid values: masked, unmasked, restricted",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"masked", "unmasked", "restricted"}, false),
+			},
+		},
+	}
+}
+
+func resourceWorkplaceSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+
+	// Only the configured settings are sent, any others are left as they are in Doppler
+	settings := map[string]interface{}{}
+	rawConfig := d.GetRawConfig()
+	for _, field := range workplaceSettingsFields {
+		if !rawConfig.GetAttr(field).IsNull() {
+			settings[field] = d.Get(field)
+		}
+	}
+
+	workplace, err := client.UpdateWorkplaceSettings(ctx, settings)
+	if err != nil {
+		return apiErrorDiagnostics(err, d, nil)
+	}
+
+	d.SetId(workplace.Slug)
+
+	if err = updateWorkplaceSettingsState(d, workplace); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceWorkplaceSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+
+	settings := map[string]interface{}{}
+	for _, field := range workplaceSettingsFields {
+		if d.HasChange(field) {
+			settings[field] = d.Get(field)
+		}
+	}
+
+	workplace, err := client.UpdateWorkplaceSettings(ctx, settings)
+	if err != nil {
+		return apiErrorDiagnostics(err, d, nil)
+	}
+
+	if err = updateWorkplaceSettingsState(d, workplace); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceWorkplaceSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+
+	workplace, err := client.GetWorkplaceSettings(ctx)
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	d.SetId(workplace.Slug)
+
+	if err = updateWorkplaceSettingsState(d, workplace); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceWorkplaceSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Workplace settings can't be deleted, so destroying this resource only removes it from the Terraform state
+	d.SetId("")
+
+	return diags
+}
+
+func updateWorkplaceSettingsState(d *schema.ResourceData, workplace *WorkplaceSettings) error {
+	if err := d.Set("name", workplace.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("billing_email", workplace.BillingEmail); err != nil {
+		return err
+	}
+
+	if err := d.Set("security_email", workplace.SecurityEmail); err != nil {
+		return err
+	}
+
+	if err := d.Set("enforce_sso", workplace.EnforceSSO); err != nil {
+		return err
+	}
+
+	if err := d.Set("require_2fa", workplace.Require2FA); err != nil {
+		return err
+	}
+
+	if err := d.Set("default_secret_visibility", workplace.DefaultSecretVisibility); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceWorkplaceSSO() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkplaceSSOUpdate,
+		ReadContext:   resourceWorkplaceSSORead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		UpdateContext: resourceWorkplaceSSOUpdate,
+		DeleteContext: resourceWorkplaceSSODelete,
+		Schema: map[string]*schema.Schema{
+			"metadata_xml": {
+				Description:  "The SAML metadata XML document provided by the identity provider",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"metadata_xml", "metadata_url"},
+			},
+			"metadata_url": {
+				Description:  "The URL of the identity provider's SAML metadata",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"metadata_xml", "metadata_url"},
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"enabled": {
+				Description: "Whether SAML SSO is enabled for the workplace. Defaults to true",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"scim_enabled": {
+				Description: "Whether SCIM user provisioning is enabled for the workplace. Defaults to false",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"sp_entity_id": {
+				Description: "The SAML service provider entity ID to configure in the identity provider",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"sp_acs_url": {
+				Description: "The SAML assertion consumer service URL to configure in the identity provider",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"scim_base_url": {
+				Description: "The SCIM base URL to configure in the identity provider",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceWorkplaceSSOUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+
+	saml := WorkplaceSAML{
+		Enabled:     d.Get("enabled").(bool),
+		MetadataXML: d.Get("metadata_xml").(string),
+		MetadataURL: d.Get("metadata_url").(string),
+		SCIMEnabled: d.Get("scim_enabled").(bool),
+	}
+
+	result, err := client.UpdateWorkplaceSAML(ctx, &saml)
+	if err != nil {
+		return apiErrorDiagnostics(err, d, nil)
+	}
+
+	// SAML settings are a workplace-wide singleton
+	d.SetId("saml")
+
+	if err = updateWorkplaceSSOState(d, result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceWorkplaceSSORead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+
+	saml, err := client.GetWorkplaceSAML(ctx)
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	if err = updateWorkplaceSSOState(d, saml); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceWorkplaceSSODelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeleteWorkplaceSAML(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateWorkplaceSSOState(d *schema.ResourceData, saml *WorkplaceSAML) error {
+	// Only the metadata source that's in use is returned by the API
+	if saml.MetadataURL != "" {
+		if err := d.Set("metadata_url", saml.MetadataURL); err != nil {
+			return err
+		}
+		if err := d.Set("metadata_xml", ""); err != nil {
+			return err
+		}
+	} else {
+		if err := d.Set("metadata_xml", saml.MetadataXML); err != nil {
+			return err
+		}
+		if err := d.Set("metadata_url", ""); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("enabled", saml.Enabled); err != nil {
+		return err
+	}
+
+	if err := d.Set("scim_enabled", saml.SCIMEnabled); err != nil {
+		return err
+	}
+
+	if err := d.Set("sp_entity_id", saml.SPEntityId); err != nil {
+		return err
+	}
+
+	if err := d.Set("sp_acs_url", saml.SPACSUrl); err != nil {
+		return err
+	}
+
+	if err := d.Set("scim_base_url", saml.SCIMBaseUrl); err != nil {
+		return err
+	}
+	return nil
+}
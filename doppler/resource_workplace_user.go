@@ -0,0 +1,139 @@
+package doppler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWorkplaceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkplaceUserCreate,
+		ReadContext:   resourceWorkplaceUserRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		UpdateContext: resourceWorkplaceUserUpdate,
+		DeleteContext: resourceWorkplaceUserDelete,
+		Schema: map[string]*schema.Schema{
+			"slug": {
+				Description: "The slug of the workplace user",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"email": {
+				Description: "The email address of the user to invite to the workplace",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				// Doppler may store the email with different casing than configured, which is the same user
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					return strings.EqualFold(oldValue, newValue)
+				},
+			},
+			"workplace_role": {
+				Description: "The identifier of the workplace role assigned to the user (e.g. `admin`, `collaborator`, or a custom role)",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description: "The name of the user",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceWorkplaceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	email := d.Get("email").(string)
+	workplaceRole := d.Get("workplace_role").(string)
+
+	user, err := client.InviteWorkplaceUser(ctx, email, workplaceRole)
+	if err != nil {
+		return apiErrorDiagnostics(err, d, nil)
+	}
+
+	d.SetId(user.Slug)
+
+	if err = updateWorkplaceUserState(d, user); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceWorkplaceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	slug := d.Id()
+
+	if d.HasChange("workplace_role") {
+		user, err := client.UpdateWorkplaceUser(ctx, slug, d.Get("workplace_role").(string))
+		if err != nil {
+			return apiErrorDiagnostics(err, d, nil)
+		}
+
+		if err = updateWorkplaceUserState(d, user); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceWorkplaceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	slug := d.Id()
+
+	user, err := client.GetWorkplaceUserBySlug(ctx, slug)
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	if err = updateWorkplaceUserState(d, user); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceWorkplaceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	slug := d.Id()
+
+	if err := client.DeleteWorkplaceUser(ctx, slug); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateWorkplaceUserState(d *schema.ResourceData, user *WorkplaceUser) error {
+	if err := d.Set("slug", user.Slug); err != nil {
+		return err
+	}
+
+	if err := d.Set("email", user.User.Email); err != nil {
+		return err
+	}
+
+	if err := d.Set("workplace_role", user.WorkplaceRole.Identifier); err != nil {
+		return err
+	}
+
+	if err := d.Set("name", user.User.Name); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package doppler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestWorkplaceUserEmailCaseDiff(t *testing.T) {
+	resource := resourceWorkplaceUser()
+	state := &terraform.InstanceState{
+		ID: "user-slug",
+		Attributes: map[string]string{
+			"id":             "user-slug",
+			"slug":           "user-slug",
+			"email":          "jane.doe@example.com",
+			"workplace_role": "collaborator",
+			"name":           "Jane Doe",
+		},
+	}
+
+	tests := []struct {
+		name        string
+		email       string
+		wantReplace bool
+	}{
+		{"different casing", "Jane.Doe@Example.com", false},
+		{"different email", "john.doe@example.com", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"email":          test.email,
+				"workplace_role": "collaborator",
+			})
+			diff, err := resource.SimpleDiff(context.Background(), state, config, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got := diff != nil && diff.RequiresNew(); got != test.wantReplace {
+				t.Errorf("got replace %t, want %t", got, test.wantReplace)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+data "doppler_audit_logs" "backend_recent" {
+  project     = "backend"
+  start       = "2024-01-01T00:00:00Z"
+  max_results = 500
+}
+
+output "backend_activity" {
+  value = [for log in data.doppler_audit_logs.backend_recent.list : "${log.created_at} ${log.user_email}: ${log.text}"]
+}
@@ -0,0 +1,18 @@
+data "doppler_groups" "all" {
+  include_members = true
+}
+
+data "doppler_workplace_users" "all" {}
+
+locals {
+  user_emails = { for user in data.doppler_workplace_users.all.list : user.slug => user.email }
+}
+
+# Group membership by email, for diffing against an IdP export
+output "group_members" {
+  value = {
+    for group in data.doppler_groups.all.list : group.name => sort([
+      for member in group.members : local.user_emails[member.slug] if member.type == "workplace_user"
+    ])
+  }
+}
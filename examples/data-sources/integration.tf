@@ -0,0 +1,14 @@
+# Look up an integration managed by another team instead of hardcoding its slug
+data "doppler_integration" "aws_secrets_manager" {
+  name = "Platform AWS Secrets Manager"
+  type = "aws_secrets_manager"
+}
+
+resource "doppler_secrets_sync_aws_secrets_manager" "backend_prod" {
+  integration = data.doppler_integration.aws_secrets_manager.slug
+  project     = "backend"
+  config      = "prd"
+
+  region = "us-east-1"
+  path   = "/backend/"
+}
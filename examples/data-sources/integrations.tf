@@ -0,0 +1,7 @@
+data "doppler_integrations" "aws_secrets_manager" {
+  type = "aws_secrets_manager"
+}
+
+output "aws_secrets_manager_integrations" {
+  value = { for i in data.doppler_integrations.aws_secrets_manager.list : i.name => i.slug }
+}
@@ -0,0 +1,16 @@
+data "doppler_rotated_secrets" "backend_prd" {
+  project = "backend"
+  config  = "prd"
+}
+
+output "rotated_secret_names" {
+  value = [for rs in data.doppler_rotated_secrets.backend_prd.list : rs.name]
+}
+
+# Fail the plan if any rotation in the config has failed
+check "rotations_healthy" {
+  assert {
+    condition     = alltrue([for rs in data.doppler_rotated_secrets.backend_prd.list : rs.status != "failed"])
+    error_message = "One or more rotated secrets in backend.prd failed to rotate."
+  }
+}
@@ -0,0 +1,9 @@
+# By service account name
+data "doppler_service_account" "ci" {
+  name = "ci"
+}
+
+# By service account slug
+data "doppler_service_account" "ci" {
+  slug = "b5f4e4d5-5ec9-4a6e-9e5d-a5b4c7b9e0d1"
+}
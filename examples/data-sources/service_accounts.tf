@@ -0,0 +1,6 @@
+data "doppler_service_accounts" "all" {}
+
+# Service accounts with the workplace owner role
+output "owner_service_accounts" {
+  value = [for sa in data.doppler_service_accounts.all.list : sa.name if sa.workplace_role == "owner"]
+}
@@ -0,0 +1,5 @@
+data "doppler_token_info" "current" {}
+
+output "doppler_workplace" {
+  value = data.doppler_token_info.current.workplace_name
+}
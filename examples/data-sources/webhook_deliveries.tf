@@ -0,0 +1,8 @@
+data "doppler_webhook_deliveries" "ci" {
+  project = doppler_webhook.ci.project
+  webhook = doppler_webhook.ci.slug
+}
+
+output "failed_webhook_deliveries" {
+  value = [for delivery in data.doppler_webhook_deliveries.ci.list : delivery.status_code if !delivery.success]
+}
@@ -0,0 +1,6 @@
+data "doppler_workplace_users" "all" {}
+
+# Users without two-factor authentication enabled
+output "users_without_2fa" {
+  value = [for user in data.doppler_workplace_users.all.list : user.email if !user.two_factor_enabled]
+}
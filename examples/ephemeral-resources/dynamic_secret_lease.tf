@@ -0,0 +1,12 @@
+ephemeral "doppler_dynamic_secret_lease" "aws" {
+  project        = "backend"
+  config         = "dev"
+  dynamic_secret = doppler_dynamic_secret_aws_iam_user.ds_aws_iam_user.id
+  ttl_sec        = 900
+}
+
+provider "aws" {
+  region     = "us-east-1"
+  access_key = ephemeral.doppler_dynamic_secret_lease.aws.credentials["ACCESS_KEY_ID"]
+  secret_key = ephemeral.doppler_dynamic_secret_lease.aws.credentials["SECRET_ACCESS_KEY"]
+}
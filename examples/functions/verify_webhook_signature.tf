@@ -0,0 +1,14 @@
+variable "webhook_request_body" {
+  type = string
+}
+
+variable "webhook_request_signature" {
+  type = string
+}
+
+check "webhook_request" {
+  assert {
+    condition     = provider::doppler::verify_webhook_signature(doppler_webhook.ci.secret, var.webhook_request_body, var.webhook_request_signature)
+    error_message = "The webhook request's signature doesn't match its body"
+  }
+}
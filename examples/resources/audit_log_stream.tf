@@ -0,0 +1,20 @@
+variable "splunk_hec_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "doppler_audit_log_stream" "siem" {
+  name  = "SOC Splunk"
+  type  = "splunk_hec"
+  url   = "https://splunk.example.com:8088/services/collector/event"
+  token = var.splunk_hec_token
+}
+
+resource "doppler_audit_log_stream" "https" {
+  name = "Internal Collector"
+  type = "https"
+  url  = "https://collector.example.com/doppler"
+  headers = {
+    Authorization = "Bearer xxxxxxxxxxxxxxxx"
+  }
+}
@@ -0,0 +1,15 @@
+resource "doppler_integration_aws_iam_user_keys" "i_aws_iam_uk" {
+  name            = "TF AWS IAM UK"
+  assume_role_arn = "arn:aws:iam::xxxxxxxxxxxx:role/xxxxxxxxxxxxxxxx"
+}
+
+resource "doppler_dynamic_secret_aws_iam_user" "ds_aws_iam_user" {
+  integration         = doppler_integration_aws_iam_user_keys.i_aws_iam_uk.id
+  project             = "backend"
+  config              = "dev"
+  name                = "AWS_IAM_USER"
+  default_ttl_sec     = 3600
+  max_ttl_sec         = 86400
+  managed_policy_arns = ["arn:aws:iam::aws:policy/ReadOnlyAccess"]
+  groups              = ["xxxxxxxxxxxxx"]
+}
@@ -0,0 +1,15 @@
+resource "doppler_integration_gcp_service_account_keys" "i_gcp_sak" {
+  name                         = "TF GCP Service Account Keys"
+  impersonated_service_account = "xxxxxxxxxxx@xxxxxxxxxxxxxxxxx.iam.gserviceaccount.com"
+  external_id                  = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+}
+
+resource "doppler_dynamic_secret_gcp_service_account" "ds_gcp_sa" {
+  integration     = doppler_integration_gcp_service_account_keys.i_gcp_sak.id
+  project         = "backend"
+  config          = "dev"
+  name            = "GCP_ACCESS_TOKEN"
+  default_ttl_sec = 3600
+  service_account = "xxxxxxxxxxxxxxxxxx@xxxxxxxxxxxxxxxxx.iam.gserviceaccount.com"
+  scopes          = ["https://www.googleapis.com/auth/cloud-platform"]
+}
@@ -0,0 +1,13 @@
+resource "doppler_group" "ci" {
+  name = "ci"
+}
+
+resource "doppler_service_account" "github_actions" {
+  name           = "github_actions"
+  workplace_role = "viewer"
+}
+
+resource "doppler_group_member_service_account" "ci" {
+  group_slug           = doppler_group.ci.slug
+  service_account_slug = doppler_service_account.github_actions.slug
+}
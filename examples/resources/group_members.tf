@@ -18,3 +18,13 @@ resource "doppler_group_members" "engineering" {
   ]
 }
 
+resource "doppler_service_account" "deployer" {
+  name = "deployer"
+}
+
+# Only manage the listed members, leaving any other memberships of the group untouched
+resource "doppler_group_members" "engineering_service_accounts" {
+  group_slug            = doppler_group.engineering.slug
+  authoritative         = false
+  service_account_slugs = [doppler_service_account.deployer.slug]
+}
@@ -60,5 +60,10 @@ resource "doppler_secrets_sync_aws_secrets_manager" "backend_prod" {
   tags   = { myTag = "enabled" }
 
   delete_behavior = "leave_in_target"
+
+  # Syncing a large config can take longer than the default timeouts
+  timeouts {
+    create = "30m"
+  }
 }
 
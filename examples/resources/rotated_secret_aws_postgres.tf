@@ -37,6 +37,11 @@ resource "doppler_rotated_secret_aws_postgres" "rs_aws_postgres" {
     username = "xxxxxxxxxxx"
     password = var.db_password_2
   }
+  # Optional: write the rotated credentials to fixed secret names instead of ones derived from `name`
+  secret_names {
+    username = "DB_USER"
+    password = "DB_PASSWORD"
+  }
   lifecycle {
     # The credentials are rotated regularly by Doppler, and cannot be updated via TF after initialization, so skip checking the credentials against state.
     ignore_changes = [credentials]
@@ -22,4 +22,24 @@ resource "doppler_service_account_identity" "github_oidc" {
       ]
     }
   }
-}
\ No newline at end of file
+}
+resource "doppler_service_account_identity" "aws_iam" {
+  service_account_slug = doppler_service_account.ci.slug
+  name = "AWS IAM"
+  ttl_seconds = 600
+  config_aws_iam {
+    aws_account_id = "123456789012"
+    allowed_principal_arns = ["arn:aws:iam::123456789012:role/ci-runner"]
+  }
+}
+
+resource "doppler_service_account_identity" "kubernetes" {
+  service_account_slug = doppler_service_account.ci.slug
+  name = "Kubernetes"
+  ttl_seconds = 600
+  config_kubernetes {
+    kubernetes_host = "https://kubernetes.example.com"
+    allowed_namespaces = ["ci"]
+    allowed_service_accounts = ["runner"]
+  }
+}
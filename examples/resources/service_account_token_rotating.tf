@@ -0,0 +1,20 @@
+resource "doppler_service_account" "ci" {
+  name           = "ci"
+  workplace_role = "collaborator"
+}
+
+resource "doppler_service_account_token_rotating" "ci" {
+  service_account_slug = doppler_service_account.ci.slug
+  name                 = "CI Token"
+  rotation_days        = 30
+  overlap_days         = 7
+}
+
+# Publish the current key to CI. The previous key stays valid for `overlap_days` after each rotation,
+# so running jobs aren't interrupted while CI picks up the new key.
+resource "doppler_secret" "ci_token" {
+  project = "ci"
+  config  = "prd"
+  name    = "DOPPLER_TOKEN"
+  value   = doppler_service_account_token_rotating.ci.current_api_key
+}
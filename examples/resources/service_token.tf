@@ -6,3 +6,11 @@ resource "doppler_service_token" "backend_ci_token" {
 }
 
 # Service token key available as `doppler_service_token.backend_ci_token.key`
+
+resource "doppler_service_token" "backend_ci_temporary_token" {
+  project = "backend"
+  config = "ci"
+  name = "Temporary Builder Token"
+  access = "read"
+  expire_at = "2030-01-01T00:00:00Z"
+}
@@ -5,6 +5,7 @@ resource "doppler_webhook" "ci" {
   secret = "my signing secret-2"
   enabled = true
   enabled_configs = [doppler_config.ci_github.name]
+  events = ["secrets.update", "sync.failed"]
   authentication {
     type = "Bearer"
     token = "my bearer token"
@@ -0,0 +1,7 @@
+resource "doppler_workplace_settings" "default" {
+  billing_email             = "billing@example.com"
+  security_email            = "security@example.com"
+  enforce_sso               = true
+  require_2fa               = true
+  default_secret_visibility = "masked"
+}
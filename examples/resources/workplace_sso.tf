@@ -0,0 +1,8 @@
+resource "doppler_workplace_sso" "default" {
+  metadata_url = "https://example.okta.com/app/xxxxxxxxxxxxxxxxxxxx/sso/saml/metadata"
+  scim_enabled = true
+}
+
+output "saml_acs_url" {
+  value = doppler_workplace_sso.default.sp_acs_url
+}
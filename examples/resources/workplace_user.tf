@@ -0,0 +1,9 @@
+variable "engineers" {
+  type = set(string)
+}
+
+resource "doppler_workplace_user" "engineers" {
+  for_each       = var.engineers
+  email          = each.value
+  workplace_role = "collaborator"
+}
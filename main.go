@@ -1,16 +1,23 @@
 package main
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 
 	"github.com/DopplerHQ/terraform-provider-doppler/doppler"
 )
 
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: func() *schema.Provider {
-			return doppler.Provider()
-		},
-	})
+	ctx := context.Background()
+
+	providerServer, err := doppler.ProviderServer(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err = tf5server.Serve("registry.terraform.io/DopplerHQ/doppler", providerServer); err != nil {
+		log.Fatal(err)
+	}
 }